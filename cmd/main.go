@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"time"
+	_ "time/tzdata" // 스케줄 타임존 처리를 위해 tzdata가 없는 컨테이너에서도 IANA 타임존 데이터 포함
 
 	"github.com/joho/godotenv"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
@@ -26,8 +29,25 @@ func main() {
 	defer db.Close()
 
 	// 3. 스케줄러 초기화
-	awsClient := aws.NewAWSClient()
+	awsClient, err := aws.NewAWSClient(context.Background(), db, aws.ClientConfigFromEnv())
+	if err != nil {
+		log.Fatalf("Failed to initialize AWS client: %v", err)
+	}
 	mainScheduler := scheduler.NewScheduler(db, awsClient)
+	if value := os.Getenv("ACTION_WAIT_TIMEOUT"); value != "" {
+		waitTimeout, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid ACTION_WAIT_TIMEOUT: %v", err)
+		}
+		mainScheduler.WaitTimeout = waitTimeout
+	}
+	if value := os.Getenv("RECONCILE_INTERVAL"); value != "" {
+		reconcileInterval, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid RECONCILE_INTERVAL: %v", err)
+		}
+		mainScheduler.ReconcileInterval = reconcileInterval
+	}
 	mainScheduler.Start()
 
 	// 4. 서버 실행 (서버는 스케줄러와 데이터베이스를 의존성으로 가짐)
@@ -0,0 +1,12 @@
+-- 리소스 그룹 스케줄 테이블
+CREATE TABLE IF NOT EXISTS schedules (
+    id SERIAL PRIMARY KEY,
+    group_id INT REFERENCES resource_groups(id) ON DELETE CASCADE,
+    start_cron VARCHAR(100) NOT NULL, -- 시작 작업 cron 표현식 (초 분 시 일 월 요일)
+    stop_cron VARCHAR(100) NOT NULL,  -- 중지 작업 cron 표현식
+    timezone VARCHAR(64) NOT NULL DEFAULT '', -- IANA 타임존 이름 (빈 값이면 서버 로컬 시간)
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedules_group_id ON schedules (group_id);
@@ -0,0 +1,18 @@
+-- 휴일 캘린더 테이블
+CREATE TABLE IF NOT EXISTS holiday_calendars (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 휴일 캘린더에 포함된 날짜 테이블
+CREATE TABLE IF NOT EXISTS holiday_calendar_dates (
+    id SERIAL PRIMARY KEY,
+    calendar_id INT REFERENCES holiday_calendars(id) ON DELETE CASCADE,
+    holiday_date DATE NOT NULL,
+    name VARCHAR(200) NOT NULL DEFAULT '', -- 휴일 이름 (예: 설날)
+    UNIQUE (calendar_id, holiday_date)
+);
+
+-- 스케줄에 휴일 캘린더 연결 (휴일에는 시작 작업을 건너뜀)
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS calendar_id INT REFERENCES holiday_calendars(id) ON DELETE SET NULL;
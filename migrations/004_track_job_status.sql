@@ -0,0 +1,5 @@
+-- 작업 상태를 리소스 항목별로 기록하기 위한 컬럼 추가
+-- resource_type이 NULL인 행은 작업 전체 상태, 그 외의 행은 그룹의 리소스 항목별 상태
+ALTER TABLE job_status ADD COLUMN IF NOT EXISTS resource_type VARCHAR(50);
+ALTER TABLE job_status ADD COLUMN IF NOT EXISTS resource_ids TEXT[] NOT NULL DEFAULT '{}'; -- 처리 대상 리소스 ID 목록
+ALTER TABLE job_status ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
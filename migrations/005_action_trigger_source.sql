@@ -0,0 +1,5 @@
+-- 작업을 실행한 주체 기록 (api: 수동 요청, cron: 스케줄, override: 스케줄을 무시한 수동 요청)
+ALTER TABLE action_logs ADD COLUMN IF NOT EXISTS trigger_source VARCHAR(20) NOT NULL DEFAULT 'api';
+ALTER TABLE action_logs ADD COLUMN IF NOT EXISTS requested_by VARCHAR(100) NOT NULL DEFAULT ''; -- JWT 사용자 또는 스케줄 ID
+
+CREATE INDEX IF NOT EXISTS idx_action_logs_group_id_created_at ON action_logs (group_id, created_at DESC);
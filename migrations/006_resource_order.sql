@@ -0,0 +1,4 @@
+-- 리소스 항목별 시작 순서와 의존 관계 (중지 시에는 역순으로 실행)
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS name VARCHAR(100) NOT NULL DEFAULT ''; -- depends_on에서 참조하는 항목 이름
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS start_order INT NOT NULL DEFAULT 0;   -- 값이 작을수록 먼저 시작
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS depends_on TEXT[] NOT NULL DEFAULT '{}'; -- 먼저 시작해야 하는 항목 이름 목록
@@ -0,0 +1,13 @@
+-- ECS 서비스 중지 전 용량 (재시작 후에도 시작 시 복원할 수 있도록 저장)
+CREATE TABLE IF NOT EXISTS ecs_service_capacities (
+    cluster_arn VARCHAR(2048) NOT NULL,
+    service_arn VARCHAR(2048) NOT NULL,
+    desired_count INT NOT NULL, -- 중지 전 DesiredCount
+    min_capacity INT,           -- 중지 전 Application Auto Scaling 최소 용량 (없으면 NULL)
+    max_capacity INT,           -- 중지 전 Application Auto Scaling 최대 용량 (없으면 NULL)
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (cluster_arn, service_arn)
+);
+
+-- 저장된 용량이 없는 ECS 서비스를 시작할 때 사용할 그룹별 기본 DesiredCount
+ALTER TABLE resource_groups ADD COLUMN IF NOT EXISTS ecs_default_desired_count INT NOT NULL DEFAULT 1;
@@ -0,0 +1,17 @@
+-- 리소스 항목의 태그 목록을 하나의 선택자로 저장 (모든 태그가 일치해야 하는 AND 조건)
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS tags JSONB NOT NULL DEFAULT '[]';
+
+-- 기존 행은 태그 하나씩 독립된 항목으로 동작했으므로 동작이 바뀌지 않도록 각 행을 태그 하나짜리 선택자로 옮긴 뒤 이전 컬럼 삭제
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'resource_group_resources' AND column_name = 'tag_key'
+    ) THEN
+        UPDATE resource_group_resources
+        SET tags = jsonb_build_array(jsonb_build_object('key', tag_key, 'value', tag_value))
+        WHERE tags = '[]'::jsonb;
+
+        ALTER TABLE resource_group_resources DROP COLUMN tag_key, DROP COLUMN tag_value;
+    END IF;
+END $$;
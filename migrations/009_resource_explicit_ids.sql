@@ -0,0 +1,3 @@
+-- 태그가 없는 리소스를 위한 명시적 리소스 ID/ARN 목록과 제외할 리소스 ID/ARN 목록
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS resource_ids TEXT[] NOT NULL DEFAULT '{}'; -- 태그 일치 결과에 추가할 ID
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS exclude_ids TEXT[] NOT NULL DEFAULT '{}';  -- 결과에서 제외할 ID
@@ -0,0 +1,6 @@
+-- 리소스 그룹과 리소스 항목이 작업할 AWS 리전 (비어 있으면 그룹 설정, 그룹도 비어 있으면 기본 리전)
+ALTER TABLE resource_groups ADD COLUMN IF NOT EXISTS regions TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE resource_group_resources ADD COLUMN IF NOT EXISTS regions TEXT[] NOT NULL DEFAULT '{}';
+
+-- 리소스 항목별 상태가 어느 리전의 결과인지 기록
+ALTER TABLE job_status ADD COLUMN IF NOT EXISTS region VARCHAR(50);
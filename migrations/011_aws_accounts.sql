@@ -0,0 +1,13 @@
+-- 리소스 그룹이 STS AssumeRole로 제어할 AWS 계정 테이블
+CREATE TABLE IF NOT EXISTS aws_accounts (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    aws_account_id VARCHAR(12) NOT NULL,
+    role_arn VARCHAR(2048) NOT NULL,
+    external_id VARCHAR(1224) NOT NULL DEFAULT '', -- 역할의 신뢰 정책이 요구하는 외부 ID
+    regions TEXT[] NOT NULL DEFAULT '{}',          -- 그룹에 리전이 없을 때 사용하는 기본 리전
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 리소스 그룹에 계정 연결 (비어 있으면 서버의 자격 증명 사용, 그룹이 사용 중인 계정은 삭제할 수 없음)
+ALTER TABLE resource_groups ADD COLUMN IF NOT EXISTS account_id INT REFERENCES aws_accounts(id) ON DELETE RESTRICT;
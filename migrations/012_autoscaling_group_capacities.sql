@@ -0,0 +1,9 @@
+-- EC2 Auto Scaling 그룹 중지 전 용량 (중지 시 0으로 줄이고 시작 시 복원)
+CREATE TABLE IF NOT EXISTS autoscaling_group_capacities (
+    group_arn VARCHAR(2048) PRIMARY KEY, -- 그룹 이름은 리전/계정마다 겹칠 수 있으므로 ARN으로 구분
+    group_name VARCHAR(255) NOT NULL,
+    min_size INT NOT NULL,
+    max_size INT NOT NULL,
+    desired_capacity INT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,3 @@
+-- 스케줄 마지막 변경 시각 (리컨사일러는 이 시각 이전의 cron 실행 시각을 원하는 상태 계산에 사용하지 않음)
+-- 기존 스케줄은 마이그레이션 시각으로 채워지므로 그 이전의 실행 시각은 신뢰하지 않음
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// assumeRoleSessionName은 다른 계정의 역할을 맡을 때 CloudTrail에 기록되는 세션 이름입니다.
+const assumeRoleSessionName = "cloudtoggle"
+
+var (
+	// accountIDPattern은 12자리 AWS 계정 ID 형식입니다.
+	accountIDPattern = regexp.MustCompile(`^\d{12}$`)
+	// roleARNPattern은 IAM 역할 ARN 형식이며 계정 ID를 캡처합니다 (예: arn:aws:iam::123456789012:role/CloudToggle).
+	roleARNPattern = regexp.MustCompile(`^arn:aws[a-z-]*:iam::(\d{12}):role/.+$`)
+)
+
+// ForAccount는 계정의 역할을 STS AssumeRole로 맡은 자격 증명을 사용하는 AWSClient를 반환합니다.
+// 클라이언트는 역할 ARN과 외부 ID별로 캐시되며, 임시 자격 증명은 만료 전에 자동으로 갱신됩니다.
+// 반환된 클라이언트의 리전은 기본 리전이며, 다른 리전은 ForRegion으로 가져옵니다.
+func (c *AWSClient) ForAccount(account models.AWSAccount) *AWSClient {
+	key := account.RoleARN + "|" + account.ExternalID
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, exists := c.accounts[key]; exists {
+		return client
+	}
+
+	stsClient := sts.NewFromConfig(c.config, func(o *sts.Options) {
+		o.BaseEndpoint = c.options.endpoint(ServiceSTS)
+	})
+	provider := stscreds.NewAssumeRoleProvider(stsClient, account.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = assumeRoleSessionName
+		if account.ExternalID != "" {
+			o.ExternalID = aws.String(account.ExternalID)
+		}
+	})
+
+	cfg := c.config.Copy()
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+	client := newAWSClientFromConfig(cfg, c.options, c.newAPIs, c.store)
+	c.accounts[key] = client
+	log.Printf("Created AWS clients for account %s (%s)", account.AccountID, account.RoleARN)
+	return client
+}
+
+// ValidateAccount는 계정 ID와 역할 ARN 형식을 확인하고, 역할 ARN이 같은 계정의 역할인지 확인합니다.
+func ValidateAccount(account models.AWSAccount) error {
+	if !accountIDPattern.MatchString(account.AccountID) {
+		return fmt.Errorf("invalid account ID %q: must be 12 digits", account.AccountID)
+	}
+	match := roleARNPattern.FindStringSubmatch(account.RoleARN)
+	if match == nil {
+		return fmt.Errorf("invalid role ARN %q", account.RoleARN)
+	}
+	if match[1] != account.AccountID {
+		return fmt.Errorf("role ARN %q does not belong to account %s", account.RoleARN, account.AccountID)
+	}
+	return ValidateRegions(account.Regions)
+}
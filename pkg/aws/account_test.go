@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func TestValidateAccount(t *testing.T) {
+	valid := models.AWSAccount{
+		AccountID: "123456789012",
+		RoleARN:   "arn:aws:iam::123456789012:role/CloudToggle",
+		Regions:   []string{"ap-northeast-2"},
+	}
+	if err := ValidateAccount(valid); err != nil {
+		t.Errorf("ValidateAccount returned error for a valid account: %v", err)
+	}
+
+	invalid := []models.AWSAccount{
+		{AccountID: "12345", RoleARN: "arn:aws:iam::12345:role/CloudToggle"},
+		{AccountID: "123456789012", RoleARN: "arn:aws:iam::123456789012:user/admin"},
+		{AccountID: "123456789012", RoleARN: "arn:aws:iam::210987654321:role/CloudToggle"},
+		{AccountID: "123456789012", RoleARN: "arn:aws:iam::123456789012:role/CloudToggle", Regions: []string{"seoul"}},
+	}
+	for _, account := range invalid {
+		if err := ValidateAccount(account); err == nil {
+			t.Errorf("ValidateAccount(%+v) returned nil, want error", account)
+		}
+	}
+}
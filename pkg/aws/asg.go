@@ -0,0 +1,234 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// autoScalingGroupTag는 Auto Scaling 그룹이 시작한 EC2 인스턴스에 붙는 그룹 이름 태그입니다.
+const autoScalingGroupTag = "aws:autoscaling:groupName"
+
+// AutoScalingAPI는 ASGManager가 사용하는 EC2 Auto Scaling 클라이언트 메서드입니다. *autoscaling.Client가 구현합니다.
+type AutoScalingAPI interface {
+	autoscaling.DescribeAutoScalingGroupsAPIClient
+	UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+}
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "ASG",
+		DisplayName: "EC2 Auto Scaling Group",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewASGManager(apis.AutoScaling, store)
+		},
+	})
+}
+
+// ASGManager는 EC2 Auto Scaling 그룹의 용량을 조정하여 그룹 단위로 시작/중지합니다.
+// 그룹의 인스턴스를 직접 중지하면 Auto Scaling이 바로 교체하므로, 중지 시 최소/최대/희망 용량을 저장하고 모두 0으로 줄이며
+// 시작 시 저장한 용량을 복원합니다. 리소스 ID는 Auto Scaling 그룹 이름입니다.
+type ASGManager struct {
+	client AutoScalingAPI
+	// 그룹의 중지 전 용량을 저장하는 저장소 (재시작 후에도 유지)
+	store CapacityStore
+}
+
+// NewASGManager는 ASGManager를 생성합니다. store가 nil이면 프로세스 메모리에 용량을 저장합니다.
+func NewASGManager(client AutoScalingAPI, store CapacityStore) *ASGManager {
+	if store == nil {
+		store = newMemoryCapacityStore()
+	}
+	return &ASGManager{
+		client: client,
+		store:  store,
+	}
+}
+
+// Start는 중지 전에 저장한 용량으로 그룹을 복원하고, 복원한 그룹의 저장된 용량을 삭제합니다.
+// 삭제하지 않으면 이후 수동으로 바꾼 용량 대신 오래된 값이 다음 시작 때 복원되기 때문입니다.
+// 저장된 용량이 없는 그룹은 용량이 있으면 그대로 두고, 최소/최대/희망 용량이 모두 0이면 (CloudToggle 밖에서 중지했거나
+// 이 기능 이전에 중지한 그룹) 복원할 값을 알 수 없으므로 에러로 처리합니다.
+// 한 그룹이 실패해도 나머지 그룹은 계속 시작하며, 그룹별 에러를 모아 반환합니다.
+func (m *ASGManager) Start(ctx context.Context, groupNames []string) error {
+	groups, err := describeAutoScalingGroups(ctx, m.client, groupNames)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, groupName := range groupNames {
+		group, exists := groups[groupName]
+		if !exists {
+			errs = append(errs, fmt.Errorf("auto scaling group %s not found", groupName))
+			continue
+		}
+		if err := m.startGroup(ctx, group); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// startGroup은 하나의 그룹을 저장된 용량으로 복원합니다.
+func (m *ASGManager) startGroup(ctx context.Context, group types.AutoScalingGroup) error {
+	groupName, groupARN := aws.ToString(group.AutoScalingGroupName), aws.ToString(group.AutoScalingGroupARN)
+	capacity, found, err := m.store.GetAutoScalingCapacity(groupARN)
+	if err != nil {
+		return fmt.Errorf("failed to load capacity for auto scaling group %s: %w", groupName, err)
+	}
+	if !found {
+		if isStoppedGroup(group) {
+			return fmt.Errorf("auto scaling group %s is scaled to zero and has no stored capacity; set its min/max/desired capacity manually", groupName)
+		}
+		log.Printf("No stored capacity for auto scaling group %s; leaving min/max/desired at %d/%d/%d",
+			groupName, aws.ToInt32(group.MinSize), aws.ToInt32(group.MaxSize), aws.ToInt32(group.DesiredCapacity))
+		return nil
+	}
+
+	log.Printf("Starting auto scaling group: %s", groupName)
+	if err := m.updateCapacity(ctx, groupName, capacity.MinSize, capacity.MaxSize, capacity.DesiredCapacity); err != nil {
+		return fmt.Errorf("failed to start auto scaling group %s: %w", groupName, err)
+	}
+	if err := m.store.DeleteAutoScalingCapacity(groupARN); err != nil {
+		return fmt.Errorf("failed to delete stored capacity for auto scaling group %s: %w", groupName, err)
+	}
+	log.Printf("Successfully started auto scaling group: %s with min/max/desired %d/%d/%d",
+		groupName, capacity.MinSize, capacity.MaxSize, capacity.DesiredCapacity)
+	return nil
+}
+
+// Stop은 그룹의 현재 최소/최대/희망 용량을 저장한 뒤 모두 0으로 설정하여 인스턴스를 종료합니다.
+// 이미 중지된 그룹을 다시 중지해도 저장된 값을 0으로 덮어쓰지 않습니다.
+func (m *ASGManager) Stop(ctx context.Context, groupNames []string) error {
+	groups, err := describeAutoScalingGroups(ctx, m.client, groupNames)
+	if err != nil {
+		return err
+	}
+
+	for _, groupName := range groupNames {
+		group, exists := groups[groupName]
+		if !exists {
+			return fmt.Errorf("auto scaling group %s not found", groupName)
+		}
+
+		log.Printf("Stopping auto scaling group: %s", groupName)
+		if !isStoppedGroup(group) {
+			capacity := models.AutoScalingCapacity{
+				GroupARN:        aws.ToString(group.AutoScalingGroupARN),
+				GroupName:       groupName,
+				MinSize:         aws.ToInt32(group.MinSize),
+				MaxSize:         aws.ToInt32(group.MaxSize),
+				DesiredCapacity: aws.ToInt32(group.DesiredCapacity),
+			}
+			if err := m.store.SaveAutoScalingCapacity(capacity); err != nil {
+				return fmt.Errorf("failed to save capacity for auto scaling group %s: %w", groupName, err)
+			}
+		}
+
+		if err := m.updateCapacity(ctx, groupName, 0, 0, 0); err != nil {
+			return fmt.Errorf("failed to stop auto scaling group %s: %w", groupName, err)
+		}
+		log.Printf("Successfully stopped auto scaling group: %s", groupName)
+	}
+	return nil
+}
+
+func (m *ASGManager) updateCapacity(ctx context.Context, groupName string, minSize, maxSize, desiredCapacity int32) error {
+	_, err := m.client.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(groupName),
+		MinSize:              aws.Int32(minSize),
+		MaxSize:              aws.Int32(maxSize),
+		DesiredCapacity:      aws.Int32(desiredCapacity),
+	})
+	return err
+}
+
+func (m *ASGManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	log.Printf("Retrieving auto scaling groups with tags: %v", resourceTags)
+	matcher := NewTagMatcher(resourceTags)
+
+	var matchingGroups []string
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(m.client, &autoscaling.DescribeAutoScalingGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe auto scaling groups: %w", err)
+		}
+
+		for _, group := range output.AutoScalingGroups {
+			tags := tagMap(group.Tags, func(tag types.TagDescription) (*string, *string) { return tag.Key, tag.Value })
+			if matcher.Match(tags) {
+				matchingGroups = append(matchingGroups, aws.ToString(group.AutoScalingGroupName))
+			}
+		}
+	}
+
+	log.Printf("Matching auto scaling groups: %v", matchingGroups)
+	return matchingGroups, nil
+}
+
+// GetStates는 그룹의 희망 용량과 InService 인스턴스 수를 비교하여 공통 상태 값을 반환합니다.
+// 희망 용량과 인스턴스가 모두 0이면 stopped, 모든 인스턴스가 InService이고 희망 용량만큼 있으면 running, 그 외에는 pending입니다.
+func (m *ASGManager) GetStates(ctx context.Context, groupNames []string) (map[string]string, error) {
+	groups, err := describeAutoScalingGroups(ctx, m.client, groupNames)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]string)
+	for groupName, group := range groups {
+		desired := aws.ToInt32(group.DesiredCapacity)
+		var inService int32
+		for _, instance := range group.Instances {
+			if instance.LifecycleState == types.LifecycleStateInService {
+				inService++
+			}
+		}
+
+		switch {
+		case desired == 0 && len(group.Instances) == 0:
+			states[groupName] = StateStopped
+		case desired > 0 && inService == desired && len(group.Instances) == int(desired):
+			states[groupName] = StateRunning
+		default:
+			states[groupName] = StatePending
+		}
+	}
+	return states, nil
+}
+
+// describeAutoScalingGroups는 이름으로 Auto Scaling 그룹을 조회합니다. 없는 그룹은 결과에 포함되지 않습니다.
+func describeAutoScalingGroups(ctx context.Context, client AutoScalingAPI, groupNames []string) (map[string]types.AutoScalingGroup, error) {
+	groups := make(map[string]types.AutoScalingGroup)
+	if len(groupNames) == 0 {
+		return groups, nil
+	}
+
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(client, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: groupNames,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe auto scaling groups: %w", err)
+		}
+		for _, group := range output.AutoScalingGroups {
+			groups[aws.ToString(group.AutoScalingGroupName)] = group
+		}
+	}
+	return groups, nil
+}
+
+// isStoppedGroup은 그룹이 Stop으로 중지된 상태 (최소/최대/희망 용량 0) 인지 확인합니다.
+func isStoppedGroup(group types.AutoScalingGroup) bool {
+	return aws.ToInt32(group.MinSize) == 0 && aws.ToInt32(group.MaxSize) == 0 && aws.ToInt32(group.DesiredCapacity) == 0
+}
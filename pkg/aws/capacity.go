@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"sync"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// DefaultECSDesiredCount는 저장된 용량도 그룹 기본값도 없을 때 ECS 서비스를 시작하는 DesiredCount입니다.
+const DefaultECSDesiredCount int32 = 1
+
+// CapacityStore는 중지 전 ECS 서비스와 Auto Scaling 그룹의 용량을 저장하고 조회하는 저장소입니다 (예: *database.DB).
+type CapacityStore interface {
+	SaveServiceCapacity(capacity models.ServiceCapacity) error
+	GetServiceCapacity(clusterArn, serviceArn string) (models.ServiceCapacity, bool, error)
+	SaveAutoScalingCapacity(capacity models.AutoScalingCapacity) error
+	GetAutoScalingCapacity(groupARN string) (models.AutoScalingCapacity, bool, error)
+	DeleteAutoScalingCapacity(groupARN string) error
+}
+
+// memoryCapacityStore는 데이터베이스 없이 사용할 때의 프로세스 메모리 저장소입니다. 재시작하면 값이 사라집니다.
+type memoryCapacityStore struct {
+	mu         sync.Mutex
+	capacities map[[2]string]models.ServiceCapacity
+	groups     map[string]models.AutoScalingCapacity
+}
+
+func newMemoryCapacityStore() *memoryCapacityStore {
+	return &memoryCapacityStore{
+		capacities: make(map[[2]string]models.ServiceCapacity),
+		groups:     make(map[string]models.AutoScalingCapacity),
+	}
+}
+
+func (m *memoryCapacityStore) SaveServiceCapacity(capacity models.ServiceCapacity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.capacities[[2]string{capacity.ClusterArn, capacity.ServiceArn}] = capacity
+	return nil
+}
+
+func (m *memoryCapacityStore) GetServiceCapacity(clusterArn, serviceArn string) (models.ServiceCapacity, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	capacity, found := m.capacities[[2]string{clusterArn, serviceArn}]
+	return capacity, found, nil
+}
+
+func (m *memoryCapacityStore) SaveAutoScalingCapacity(capacity models.AutoScalingCapacity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groups[capacity.GroupARN] = capacity
+	return nil
+}
+
+func (m *memoryCapacityStore) GetAutoScalingCapacity(groupARN string) (models.AutoScalingCapacity, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	capacity, found := m.groups[groupARN]
+	return capacity, found, nil
+}
+
+func (m *memoryCapacityStore) DeleteAutoScalingCapacity(groupARN string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.groups, groupARN)
+	return nil
+}
@@ -2,35 +2,158 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
+	"sync"
 
-	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
-// AWSClient는 모든 AWS 리소스 매니저를 포함하는 클라이언트입니다.
+// regionPattern은 AWS 리전 이름 형식입니다 (예: ap-northeast-2, us-gov-west-1).
+var regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+
+// AWSClient는 등록된 모든 리소스 유형의 매니저를 포함하는 클라이언트입니다.
+// 매니저는 클라이언트의 리전과 자격 증명을 사용하며, 다른 리전은 ForRegion, 다른 계정은 ForAccount로 가져옵니다.
 type AWSClient struct {
-	EC2Manager *EC2Manager
-	ECSManager *ECSManager
-	RDSManager *RDSManager
+	managers map[string]AWSResourceManager // 등록된 리소스 유형별 매니저
+
+	config   aws.Config
+	options  ClientConfig                    // 엔드포인트 등 리전/계정 클라이언트에도 적용할 설정
+	newAPIs  func(cfg aws.Config) ClientAPIs // 리전/계정 클라이언트의 서비스 클라이언트 생성 함수
+	store    CapacityStore
+	mu       sync.Mutex
+	regions  map[string]*AWSClient // 리전별로 생성한 클라이언트 캐시
+	accounts map[string]*AWSClient // 역할별로 생성한 계정 클라이언트 캐시
 }
 
-// NewAWSClient는 모든 AWS 리소스 매니저를 초기화하여 AWSClient를 반환합니다.
-func NewAWSClient() *AWSClient {
-	cfg, err := config.LoadDefaultConfig(context.TODO())
+// NewAWSClient는 clientConfig를 적용한 SDK 설정으로 모든 AWS 리소스 매니저를 초기화하여 AWSClient를 반환합니다.
+// store는 ECS 서비스의 중지 전 용량을 저장하는 데 사용됩니다.
+func NewAWSClient(ctx context.Context, store CapacityStore, clientConfig ClientConfig) (*AWSClient, error) {
+	if err := clientConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid AWS client configuration: %w", err)
+	}
+
+	cfg, err := clientConfig.loadConfig(ctx)
 	if err != nil {
-		log.Fatalf("Unable to load AWS SDK configuration: %v", err)
+		return nil, err
+	}
+
+	return newAWSClientFromConfig(cfg, clientConfig, sdkAPIs(clientConfig), store), nil
+}
+
+// NewAWSClientFromAPIs는 SDK 클라이언트 대신 apis가 반환하는 서비스 클라이언트를 사용하는 AWSClient를 반환합니다.
+// 가짜 클라이언트로 테스트할 때 사용하며, apis는 기본 리전과 ForRegion으로 요청된 리전마다 한 번 호출됩니다.
+// ForAccount로 가져온 클라이언트도 같은 apis를 사용합니다.
+func NewAWSClientFromAPIs(region string, store CapacityStore, apis func(region string) ClientAPIs) *AWSClient {
+	cfg := aws.Config{Region: region}
+	newAPIs := func(cfg aws.Config) ClientAPIs { return apis(cfg.Region) }
+	return newAWSClientFromConfig(cfg, ClientConfig{}, newAPIs, store)
+}
+
+// ClientAPIs는 AWSClient의 매니저가 사용하는 서비스 클라이언트입니다.
+type ClientAPIs struct {
+	EC2         EC2API
+	ECS         ECSAPI
+	Scaling     ApplicationAutoScalingAPI // nil이면 ECS 서비스의 Auto Scaling을 다루지 않음
+	RDS         RDSAPI
+	RDSCluster  RDSClusterAPI
+	AutoScaling AutoScalingAPI
+}
+
+// sdkAPIs는 options의 엔드포인트를 적용한 SDK 서비스 클라이언트를 생성하는 함수를 반환합니다.
+func sdkAPIs(options ClientConfig) func(cfg aws.Config) ClientAPIs {
+	return func(cfg aws.Config) ClientAPIs {
+		rdsClient := rds.NewFromConfig(cfg, func(o *rds.Options) {
+			o.BaseEndpoint = options.endpoint(ServiceRDS)
+		})
+		return ClientAPIs{
+			EC2: ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.BaseEndpoint = options.endpoint(ServiceEC2)
+			}),
+			ECS: ecs.NewFromConfig(cfg, func(o *ecs.Options) {
+				o.BaseEndpoint = options.endpoint(ServiceECS)
+			}),
+			Scaling: applicationautoscaling.NewFromConfig(cfg, func(o *applicationautoscaling.Options) {
+				o.BaseEndpoint = options.endpoint(ServiceApplicationAutoScaling)
+			}),
+			RDS:        rdsClient,
+			RDSCluster: rdsClient,
+			AutoScaling: autoscaling.NewFromConfig(cfg, func(o *autoscaling.Options) {
+				o.BaseEndpoint = options.endpoint(ServiceAutoScaling)
+			}),
+		}
+	}
+}
+
+// newAWSClientFromConfig는 설정의 리전과 자격 증명으로 newAPIs가 생성한 서비스 클라이언트를 사용하는 AWSClient를 생성합니다.
+func newAWSClientFromConfig(cfg aws.Config, options ClientConfig, newAPIs func(cfg aws.Config) ClientAPIs, store CapacityStore) *AWSClient {
+	// 매니저와 리전/계정 클라이언트가 같은 용량 저장소를 공유하도록 기본 저장소를 한 번만 생성
+	if store == nil {
+		store = newMemoryCapacityStore()
 	}
 
-	ec2Client := ec2.NewFromConfig(cfg)
-	ecsClient := ecs.NewFromConfig(cfg)
-	rdsClient := rds.NewFromConfig(cfg)
+	apis := newAPIs(cfg)
+	managers := make(map[string]AWSResourceManager)
+	for _, resourceType := range ResourceTypes() {
+		managers[resourceType.Name] = resourceType.NewManager(apis, store)
+	}
 
 	return &AWSClient{
-		EC2Manager: NewEC2Manager(ec2Client),
-		ECSManager: NewECSManager(ecsClient),
-		RDSManager: NewRDSManager(rdsClient),
+		managers: managers,
+
+		config:   cfg,
+		options:  options,
+		newAPIs:  newAPIs,
+		store:    store,
+		regions:  make(map[string]*AWSClient),
+		accounts: make(map[string]*AWSClient),
+	}
+}
+
+// Manager는 리소스 유형의 매니저를 반환합니다. 등록되지 않은 유형이면 nil을 반환합니다.
+func (c *AWSClient) Manager(resourceType string) AWSResourceManager {
+	return c.managers[resourceType]
+}
+
+// Region은 클라이언트가 사용하는 리전을 반환합니다.
+func (c *AWSClient) Region() string {
+	return c.config.Region
+}
+
+// ForRegion은 지정한 리전의 매니저를 가진 AWSClient를 반환합니다.
+// 빈 값이거나 기본 리전이면 자신을 반환하고, 그 외에는 처음 요청될 때 클라이언트를 생성하여 재사용합니다.
+func (c *AWSClient) ForRegion(region string) *AWSClient {
+	if region == "" || region == c.config.Region {
+		return c
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, exists := c.regions[region]; exists {
+		return client
+	}
+
+	cfg := c.config.Copy()
+	cfg.Region = region
+	client := newAWSClientFromConfig(cfg, c.options, c.newAPIs, c.store)
+	c.regions[region] = client
+	log.Printf("Created AWS clients for region %s", region)
+	return client
+}
+
+// ValidateRegions는 리전 이름이 AWS 리전 형식인지 확인합니다.
+func ValidateRegions(regions []string) error {
+	for _, region := range regions {
+		if !regionPattern.MatchString(region) {
+			return fmt.Errorf("invalid region %q", region)
+		}
 	}
+	return nil
 }
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// 엔드포인트를 지정할 수 있는 서비스 이름입니다.
+const (
+	ServiceEC2                    = "ec2"
+	ServiceECS                    = "ecs"
+	ServiceRDS                    = "rds"
+	ServiceApplicationAutoScaling = "application-autoscaling"
+	ServiceAutoScaling            = "autoscaling"
+	ServiceSTS                    = "sts"
+)
+
+// serviceEndpointEnv는 서비스별 엔드포인트를 읽는 환경 변수 이름입니다 (AWS SDK와 같은 이름).
+var serviceEndpointEnv = map[string]string{
+	ServiceEC2:                    "AWS_ENDPOINT_URL_EC2",
+	ServiceECS:                    "AWS_ENDPOINT_URL_ECS",
+	ServiceRDS:                    "AWS_ENDPOINT_URL_RDS",
+	ServiceApplicationAutoScaling: "AWS_ENDPOINT_URL_APPLICATION_AUTO_SCALING",
+	ServiceAutoScaling:            "AWS_ENDPOINT_URL_AUTO_SCALING",
+	ServiceSTS:                    "AWS_ENDPOINT_URL_STS",
+}
+
+// ClientConfig는 AWS SDK의 기본 설정 대신 사용할 값입니다.
+// 빈 값은 SDK의 기본 설정 (환경 변수, 공유 설정 파일, 인스턴스 역할 등) 을 따릅니다.
+// LocalStack이나 테스트용 목 서버를 사용할 때 엔드포인트와 고정 자격 증명을 지정합니다.
+type ClientConfig struct {
+	Region          string
+	AccessKeyID     string // SecretAccessKey와 함께 지정하면 고정 자격 증명을 사용
+	SecretAccessKey string
+	SessionToken    string
+	Endpoint        string            // 모든 서비스에 사용할 엔드포인트 (예: http://localhost:4566)
+	Endpoints       map[string]string // 서비스별 엔드포인트 (Endpoint보다 우선)
+}
+
+// ClientConfigFromEnv는 AWS_REGION, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN,
+// AWS_ENDPOINT_URL과 AWS_ENDPOINT_URL_<SERVICE> 환경 변수로 ClientConfig를 만듭니다.
+func ClientConfigFromEnv() ClientConfig {
+	clientConfig := ClientConfig{
+		Region:          os.Getenv("AWS_REGION"),
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		Endpoint:        os.Getenv("AWS_ENDPOINT_URL"),
+		Endpoints:       make(map[string]string),
+	}
+	for service, env := range serviceEndpointEnv {
+		if endpoint := os.Getenv(env); endpoint != "" {
+			clientConfig.Endpoints[service] = endpoint
+		}
+	}
+	return clientConfig
+}
+
+// Validate는 자격 증명이 함께 지정되었는지, 엔드포인트가 올바른 URL이고 지원하는 서비스인지 확인합니다.
+func (c ClientConfig) Validate() error {
+	if (c.AccessKeyID == "") != (c.SecretAccessKey == "") {
+		return fmt.Errorf("access key ID and secret access key must be set together")
+	}
+	if c.Endpoint != "" {
+		if err := validateEndpoint(c.Endpoint); err != nil {
+			return err
+		}
+	}
+	for service, endpoint := range c.Endpoints {
+		if _, ok := serviceEndpointEnv[service]; !ok {
+			return fmt.Errorf("unsupported service %q for endpoint override", service)
+		}
+		if err := validateEndpoint(endpoint); err != nil {
+			return fmt.Errorf("%s: %w", service, err)
+		}
+	}
+	return nil
+}
+
+// endpoint는 서비스에 지정된 엔드포인트를 반환합니다. 지정되지 않았으면 nil을 반환하여 SDK 기본값을 사용합니다.
+func (c ClientConfig) endpoint(service string) *string {
+	if endpoint, ok := c.Endpoints[service]; ok && endpoint != "" {
+		return aws.String(endpoint)
+	}
+	if c.Endpoint != "" {
+		return aws.String(c.Endpoint)
+	}
+	return nil
+}
+
+// loadConfig는 ClientConfig의 리전과 자격 증명을 적용하여 SDK 설정을 불러옵니다.
+func (c ClientConfig) loadConfig(ctx context.Context) (aws.Config, error) {
+	var options []func(*config.LoadOptions) error
+	if c.Region != "" {
+		options = append(options, config.WithRegion(c.Region))
+	}
+	if c.AccessKeyID != "" {
+		options = append(options, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(c.AccessKeyID, c.SecretAccessKey, c.SessionToken)))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("unable to load AWS SDK configuration: %w", err)
+	}
+	if cfg.Region == "" {
+		return aws.Config{}, fmt.Errorf("AWS region is not configured")
+	}
+	return cfg, nil
+}
+
+// validateEndpoint는 엔드포인트가 스킴과 호스트를 가진 URL인지 확인합니다.
+func validateEndpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("invalid endpoint URL %q", endpoint)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package aws
+
+import "testing"
+
+func TestClientConfigValidate(t *testing.T) {
+	valid := ClientConfig{
+		Region:          "us-east-1",
+		AccessKeyID:     "test",
+		SecretAccessKey: "test",
+		Endpoint:        "http://localhost:4566",
+		Endpoints:       map[string]string{ServiceRDS: "http://localhost:4567"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate returned error for a valid config: %v", err)
+	}
+
+	invalid := []ClientConfig{
+		{AccessKeyID: "test"},
+		{Endpoint: "localhost:4566"},
+		{Endpoints: map[string]string{"s3": "http://localhost:4566"}},
+		{Endpoints: map[string]string{ServiceEC2: "not a url"}},
+	}
+	for _, clientConfig := range invalid {
+		if err := clientConfig.Validate(); err == nil {
+			t.Errorf("Validate(%+v) returned nil, want error", clientConfig)
+		}
+	}
+}
+
+func TestClientConfigEndpoint(t *testing.T) {
+	clientConfig := ClientConfig{
+		Endpoint:  "http://localhost:4566",
+		Endpoints: map[string]string{ServiceRDS: "http://localhost:4567"},
+	}
+	if got := clientConfig.endpoint(ServiceRDS); got == nil || *got != "http://localhost:4567" {
+		t.Errorf("endpoint(rds) = %v, want the service endpoint", got)
+	}
+	if got := clientConfig.endpoint(ServiceEC2); got == nil || *got != "http://localhost:4566" {
+		t.Errorf("endpoint(ec2) = %v, want the shared endpoint", got)
+	}
+	if got := (ClientConfig{}).endpoint(ServiceEC2); got != nil {
+		t.Errorf("endpoint(ec2) = %q, want nil without overrides", *got)
+	}
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ResourceDescriber는 리소스 ID의 이름과 현재 상태를 조회할 수 있는 매니저가 구현하는 선택적 인터페이스입니다.
+// 작업 미리보기 (dry run) 에서 Start/Stop을 호출하지 않고 대상 리소스를 보여주는 데 사용됩니다.
+type ResourceDescriber interface {
+	Describe(ctx context.Context, resourceIDs []string) ([]models.ResourceInfo, error)
+}
+
+// Describe는 EC2 인스턴스의 Name 태그와 현재 상태를 반환합니다.
+func (e *EC2Manager) Describe(ctx context.Context, instanceIDs []string) ([]models.ResourceInfo, error) {
+	if len(instanceIDs) == 0 {
+		return []models.ResourceInfo{}, nil
+	}
+
+	names := make(map[string]string, len(instanceIDs))
+	paginator := ec2.NewDescribeInstancesPaginator(e.client, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe EC2 instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				tags := tagMap(instance.Tags, func(tag types.Tag) (*string, *string) { return tag.Key, tag.Value })
+				names[*instance.InstanceId] = tags["Name"]
+			}
+		}
+	}
+
+	return describeWithStates(ctx, e, instanceIDs, func(id string) string { return names[id] })
+}
+
+// Describe는 ECS 클러스터의 이름과 서비스 전체 상태를 반환합니다.
+func (e *ECSManager) Describe(ctx context.Context, clusterNames []string) ([]models.ResourceInfo, error) {
+	return describeWithStates(ctx, e, clusterNames, lastARNSegment)
+}
+
+// Describe는 ECS 서비스의 이름과 상태를 반환합니다.
+func (m *ECSServiceManager) Describe(ctx context.Context, serviceArns []string) ([]models.ResourceInfo, error) {
+	return describeWithStates(ctx, m, serviceArns, lastARNSegment)
+}
+
+// Describe는 RDS 인스턴스의 식별자와 상태를 반환합니다.
+func (r *RDSManager) Describe(ctx context.Context, dbInstanceIdentifiers []string) ([]models.ResourceInfo, error) {
+	return describeWithStates(ctx, r, dbInstanceIdentifiers, rdsIdentifier)
+}
+
+// Describe는 RDS DB 클러스터의 식별자와 상태를 반환합니다.
+func (r *RDSClusterManager) Describe(ctx context.Context, clusterIdentifiers []string) ([]models.ResourceInfo, error) {
+	return describeWithStates(ctx, r, clusterIdentifiers, rdsIdentifier)
+}
+
+// Describe는 Auto Scaling 그룹의 이름과 상태를 반환합니다.
+func (m *ASGManager) Describe(ctx context.Context, groupNames []string) ([]models.ResourceInfo, error) {
+	return describeWithStates(ctx, m, groupNames, func(name string) string { return name })
+}
+
+// describeWithStates는 GetStates로 조회한 상태와 nameOf로 구한 이름을 ID 순서대로 묶어 반환합니다.
+// 상태를 조회하지 못한 리소스 (종료된 인스턴스 등) 는 unknown으로 표시합니다.
+func describeWithStates(ctx context.Context, reader ResourceStateReader, resourceIDs []string, nameOf func(string) string) ([]models.ResourceInfo, error) {
+	infos := make([]models.ResourceInfo, 0, len(resourceIDs))
+	if len(resourceIDs) == 0 {
+		return infos, nil
+	}
+
+	states, err := reader.GetStates(ctx, resourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range resourceIDs {
+		state, exists := states[id]
+		if !exists {
+			state = StateUnknown
+		}
+		infos = append(infos, models.ResourceInfo{ID: id, Name: nameOf(id), State: state})
+	}
+	return infos, nil
+}
+
+// rdsIdentifier는 RDS 식별자 또는 ARN에서 식별자를 반환합니다.
+func rdsIdentifier(id string) string {
+	return id[strings.LastIndex(id, ":")+1:]
+}
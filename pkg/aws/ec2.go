@@ -2,17 +2,38 @@ package aws
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 )
 
+// EC2API는 EC2Manager가 사용하는 EC2 클라이언트 메서드입니다. *ec2.Client가 구현하며 테스트에서는 가짜 클라이언트로 대체할 수 있습니다.
+type EC2API interface {
+	ec2.DescribeInstancesAPIClient
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+}
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "EC2",
+		DisplayName: "EC2 Instance",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewEC2Manager(apis.EC2)
+		},
+	})
+}
+
 type EC2Manager struct {
-	client *ec2.Client
+	client EC2API
 }
 
-func NewEC2Manager(client *ec2.Client) *EC2Manager {
+func NewEC2Manager(client EC2API) *EC2Manager {
 	return &EC2Manager{client: client}
 }
 
@@ -40,21 +61,108 @@ func (e *EC2Manager) Stop(ctx context.Context, instanceIDs []string) error {
 	return nil
 }
 
+// GetByTags는 태그 선택자와 일치하는 인스턴스 ID를 반환합니다. Auto Scaling 그룹에 속한 인스턴스는 제외됩니다.
+// EC2 필터로 표현할 수 있는 선택자는 서버에서 거르고, 나머지 (not_equals, not_exists) 는 인스턴스 태그로 확인합니다.
 func (e *EC2Manager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	matcher := NewTagMatcher(resourceTags)
 	var instanceIDs []string
-	filters := BuildTagFilters(resourceTags)
+	paginator := ec2.NewDescribeInstancesPaginator(e.client, &ec2.DescribeInstancesInput{
+		Filters: BuildTagFilters(resourceTags),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	output, err := e.client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
-		Filters: filters,
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				tags := tagMap(instance.Tags, func(tag types.Tag) (*string, *string) { return tag.Key, tag.Value })
+				if !matcher.Match(tags) {
+					continue
+				}
+				if isAutoScalingInstance(*instance.InstanceId, tags) {
+					continue
+				}
+				instanceIDs = append(instanceIDs, *instance.InstanceId)
+			}
+		}
+	}
+	return instanceIDs, nil
+}
+
+// FilterIDs는 명시적으로 지정한 인스턴스 중 Auto Scaling 그룹에 속한 인스턴스를 GetByTags와 같이 경고와 함께 제외합니다.
+func (e *EC2Manager) FilterIDs(ctx context.Context, instanceIDs []string) ([]string, error) {
+	managed := make(map[string]bool)
+	paginator := ec2.NewDescribeInstancesPaginator(e.client, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
 	})
-	if err != nil {
-		return nil, err
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				tags := tagMap(instance.Tags, func(tag types.Tag) (*string, *string) { return tag.Key, tag.Value })
+				if isAutoScalingInstance(*instance.InstanceId, tags) {
+					managed[*instance.InstanceId] = true
+				}
+			}
+		}
 	}
 
-	for _, reservation := range output.Reservations {
-		for _, instance := range reservation.Instances {
-			instanceIDs = append(instanceIDs, *instance.InstanceId)
+	var filtered []string
+	for _, id := range instanceIDs {
+		if !managed[id] {
+			filtered = append(filtered, id)
 		}
 	}
-	return instanceIDs, nil
+	return filtered, nil
+}
+
+// isAutoScalingInstance는 인스턴스가 Auto Scaling 그룹에 속하는지 확인하고, 속하면 경고를 남깁니다.
+// Auto Scaling 그룹의 인스턴스는 중지하면 바로 교체되므로 ASG 타입으로 처리해야 합니다.
+func isAutoScalingInstance(instanceID string, tags map[string]string) bool {
+	groupName, exists := tags[autoScalingGroupTag]
+	if exists {
+		log.Printf("Skipping EC2 instance %s: member of auto scaling group %s (use ASG)", instanceID, groupName)
+	}
+	return exists
+}
+
+// GetStates는 EC2 인스턴스의 현재 상태를 공통 상태 값으로 반환합니다. 종료된 인스턴스는 제외됩니다.
+func (e *EC2Manager) GetStates(ctx context.Context, instanceIDs []string) (map[string]string, error) {
+	states := make(map[string]string)
+	paginator := ec2.NewDescribeInstancesPaginator(e.client, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe EC2 instances: %w", err)
+		}
+
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.State == nil {
+					continue
+				}
+				switch instance.State.Name {
+				case types.InstanceStateNameRunning:
+					states[*instance.InstanceId] = StateRunning
+				case types.InstanceStateNameStopped:
+					states[*instance.InstanceId] = StateStopped
+				case types.InstanceStateNamePending, types.InstanceStateNameStopping:
+					states[*instance.InstanceId] = StatePending
+				case types.InstanceStateNameTerminated, types.InstanceStateNameShuttingDown:
+					// 종료된 인스턴스는 다시 시작할 수 없으므로 기다리지 않음
+				default:
+					states[*instance.InstanceId] = StateUnknown
+				}
+			}
+		}
+	}
+	return states, nil
 }
@@ -11,45 +11,74 @@ import (
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 )
 
+// ECSAPI는 ECSManager와 ECSServiceManager가 사용하는 ECS 클라이언트 메서드입니다. *ecs.Client가 구현합니다.
+type ECSAPI interface {
+	ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error)
+	ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error)
+	DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error)
+	UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error)
+	ListTagsForResource(ctx context.Context, params *ecs.ListTagsForResourceInput, optFns ...func(*ecs.Options)) (*ecs.ListTagsForResourceOutput, error)
+}
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "ECS",
+		DisplayName: "ECS Cluster",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewECSManager(apis.ECS, apis.Scaling, store)
+		},
+	})
+}
+
 type ECSManager struct {
-	client *ecs.Client
-	// TODO : NoSQL DB로 변경하기
-	serviceTaskCounts map[string]int32
+	client ECSAPI
+	// 서비스의 Application Auto Scaling 대상을 중단/재개하는 클라이언트 (nil이면 Auto Scaling을 다루지 않음)
+	scaling ApplicationAutoScalingAPI
+	// 서비스의 중지 전 DesiredCount와 Auto Scaling 용량을 저장하는 저장소 (재시작 후에도 유지)
+	store CapacityStore
+	// 저장된 용량이 없는 서비스를 시작할 때의 DesiredCount (0이면 DefaultECSDesiredCount)
+	defaultDesiredCount int32
 }
 
-func NewECSManager(client *ecs.Client) *ECSManager {
+// NewECSManager는 ECSManager를 생성합니다. store가 nil이면 프로세스 메모리에 용량을 저장합니다.
+func NewECSManager(client ECSAPI, scaling ApplicationAutoScalingAPI, store CapacityStore) *ECSManager {
+	if store == nil {
+		store = newMemoryCapacityStore()
+	}
 	return &ECSManager{
-		client:            client,
-		serviceTaskCounts: make(map[string]int32),
+		client:  client,
+		scaling: scaling,
+		store:   store,
 	}
 }
 
+// WithDefaultDesiredCount는 저장된 용량이 없는 서비스를 count로 시작하는 매니저 복사본을 반환합니다.
+func (e *ECSManager) WithDefaultDesiredCount(count int32) AWSResourceManager {
+	return e.withDefaultDesiredCount(count)
+}
+
+func (e *ECSManager) withDefaultDesiredCount(count int32) *ECSManager {
+	configured := *e
+	configured.defaultDesiredCount = count
+	return &configured
+}
+
 func (e *ECSManager) Start(ctx context.Context, clusterNames []string) error {
 	for _, clusterName := range clusterNames {
 		log.Printf("Starting ECS services in cluster: %s", clusterName)
 
 		// 서비스 목록 가져오기
-		serviceNames, err := listServices(e.client, clusterName)
+		serviceNames, err := listServices(ctx, e.client, clusterName)
 		if err != nil {
 			return fmt.Errorf("failed to list services: %w", err)
 		}
 
 		for _, serviceName := range serviceNames {
-			taskCount := e.serviceTaskCounts[serviceName]
-			if taskCount == 0 {
-				taskCount = 1 // 기본적으로 1로 설정
+			if err := e.startService(ctx, clusterName, serviceName); err != nil {
+				return err
 			}
-
-			_, err := e.client.UpdateService(ctx, &ecs.UpdateServiceInput{
-				Cluster:      aws.String(clusterName),
-				Service:      aws.String(serviceName),
-				DesiredCount: aws.Int32(taskCount),
-			})
-			if err != nil {
-				return fmt.Errorf("failed to start service %s: %w", serviceName, err)
-			}
-
-			log.Printf("Successfully started ECS service: %s with task count: %d", serviceName, taskCount)
 		}
 	}
 	return nil
@@ -61,58 +90,144 @@ func (e *ECSManager) Stop(ctx context.Context, clusterNames []string) error {
 		log.Printf("Stopping ECS services in cluster: %s", clusterName)
 
 		// 서비스 목록 가져오기
-		serviceNames, err := listServices(e.client, clusterName)
+		serviceNames, err := listServices(ctx, e.client, clusterName)
 		if err != nil {
 			return fmt.Errorf("failed to list services: %w", err)
 		}
 
 		for _, serviceName := range serviceNames {
-			// 서비스의 현재 태스크 수 가져오기
-			serviceDesc, err := e.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
-				Cluster:  aws.String(clusterName),
-				Services: []string{serviceName},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to describe service %s: %w", serviceName, err)
+			if err := e.stopService(ctx, clusterName, serviceName); err != nil {
+				return err
 			}
+		}
+	}
+	return nil
+}
 
-			if len(serviceDesc.Services) > 0 {
-				e.serviceTaskCounts[serviceName] = serviceDesc.Services[0].DesiredCount
-			}
+// startService는 중지 전에 저장한 DesiredCount (없으면 그룹의 기본값) 로 서비스를 시작합니다.
+// Auto Scaling 대상이 있으면 DesiredCount를 바꾸기 전에 저장된 최소/최대 용량을 복원하고 스케일링 활동을 재개하여
+// 스케일링이 활성화된 동안 DesiredCount가 최대 용량을 넘지 않도록 합니다. 중단된 대상 (최소/최대 0) 에 저장된 용량이 없으면
+// 최소/최대 용량을 시작할 태스크 수로 등록합니다.
+func (e *ECSManager) startService(ctx context.Context, clusterName, serviceName string) error {
+	capacity, found, err := e.store.GetServiceCapacity(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("failed to load capacity for service %s: %w", serviceName, err)
+	}
 
-			// 태스크 수를 0으로 설정
-			_, err = e.client.UpdateService(ctx, &ecs.UpdateServiceInput{
-				Cluster:      aws.String(clusterName),
-				Service:      aws.String(serviceName),
-				DesiredCount: aws.Int32(0),
-			})
-			if err != nil {
-				return fmt.Errorf("failed to stop service %s: %w", serviceName, err)
-			}
+	taskCount := DefaultECSDesiredCount
+	if e.defaultDesiredCount > 0 {
+		taskCount = e.defaultDesiredCount
+	}
+	if found && capacity.DesiredCount > 0 {
+		taskCount = capacity.DesiredCount
+	}
 
-			log.Printf("Successfully stopped ECS service: %s", serviceName)
+	if e.scaling != nil {
+		resourceID := scalingResourceID(clusterName, serviceName)
+		target, err := describeScalableTarget(ctx, e.scaling, resourceID)
+		if err != nil {
+			return err
 		}
+		if target != nil {
+			minCapacity, maxCapacity := capacity.MinCapacity, capacity.MaxCapacity
+			if minCapacity == nil && isSuspendedTarget(target) {
+				log.Printf("No stored Auto Scaling capacity for %s; registering min/max %d", resourceID, taskCount)
+				minCapacity, maxCapacity = aws.Int32(taskCount), aws.Int32(taskCount)
+			}
+			if err := resumeScaling(ctx, e.scaling, resourceID, minCapacity, maxCapacity); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = e.client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterName),
+		Service:      aws.String(serviceName),
+		DesiredCount: aws.Int32(taskCount),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start service %s: %w", serviceName, err)
 	}
+
+	log.Printf("Successfully started ECS service: %s with task count: %d", serviceName, taskCount)
 	return nil
 }
 
-func (e *ECSManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
-	log.Printf("Retrieving ECS clusters with tags: %v", resourceTags)
+// stopService는 서비스의 현재 DesiredCount와 Auto Scaling 최소/최대 용량을 저장하고,
+// 스케일링 활동을 중단한 뒤 DesiredCount를 0으로 설정합니다.
+// 이미 중지된 서비스를 다시 중지해도 저장된 값을 0으로 덮어쓰지 않습니다.
+func (e *ECSManager) stopService(ctx context.Context, clusterName, serviceName string) error {
+	capacity, _, err := e.store.GetServiceCapacity(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("failed to load capacity for service %s: %w", serviceName, err)
+	}
+	capacity.ClusterArn, capacity.ServiceArn = clusterName, serviceName
+	changed := false
 
-	// List all clusters with pagination
-	var allClusters []string
-	input := &ecs.ListClustersInput{}
-	for {
-		output, err := e.client.ListClusters(ctx, input)
+	// 서비스의 현재 태스크 수 가져오기
+	serviceDesc, err := e.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterName),
+		Services: []string{serviceName},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe service %s: %w", serviceName, err)
+	}
+	if len(serviceDesc.Services) > 0 && serviceDesc.Services[0].DesiredCount > 0 {
+		capacity.DesiredCount = serviceDesc.Services[0].DesiredCount
+		changed = true
+	}
+
+	var resourceID string
+	suspend := false
+	if e.scaling != nil {
+		resourceID = scalingResourceID(clusterName, serviceName)
+		target, err := describeScalableTarget(ctx, e.scaling, resourceID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list ECS clusters: %w", err)
+			return err
 		}
-		allClusters = append(allClusters, output.ClusterArns...)
+		if target != nil {
+			suspend = true
+			if !isSuspendedTarget(target) {
+				capacity.MinCapacity, capacity.MaxCapacity = target.MinCapacity, target.MaxCapacity
+				changed = true
+			}
+		}
+	}
 
-		if output.NextToken == nil {
-			break
+	if changed {
+		if err := e.store.SaveServiceCapacity(capacity); err != nil {
+			return fmt.Errorf("failed to save capacity for service %s: %w", serviceName, err)
 		}
-		input.NextToken = output.NextToken
+	}
+
+	// 스케일링 정책이 DesiredCount를 다시 늘리지 않도록 먼저 Auto Scaling을 중단
+	if suspend {
+		if err := suspendScaling(ctx, e.scaling, resourceID); err != nil {
+			return err
+		}
+	}
+
+	// 태스크 수를 0으로 설정
+	_, err = e.client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterName),
+		Service:      aws.String(serviceName),
+		DesiredCount: aws.Int32(0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop service %s: %w", serviceName, err)
+	}
+
+	log.Printf("Successfully stopped ECS service: %s", serviceName)
+	return nil
+}
+
+func (e *ECSManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	log.Printf("Retrieving ECS clusters with tags: %v", resourceTags)
+	matcher := NewTagMatcher(resourceTags)
+
+	allClusters, err := listClusters(ctx, e.client)
+	if err != nil {
+		return nil, err
 	}
 
 	log.Printf("Retrieved all clusters: %v", allClusters)
@@ -130,7 +245,7 @@ func (e *ECSManager) GetByTags(ctx context.Context, resourceTags []models.Resour
 		}
 
 		// Check if the cluster's tags match the provided tags
-		if e.matchTags(resourceTags, tagOutput.Tags) {
+		if matcher.Match(ecsTags(tagOutput.Tags)) {
 			matchingClusters = append(matchingClusters, clusterArn)
 		}
 	}
@@ -139,12 +254,31 @@ func (e *ECSManager) GetByTags(ctx context.Context, resourceTags []models.Resour
 	return matchingClusters, nil
 }
 
-func listServices(client *ecs.Client, clusterName string) ([]string, error) {
+// listClusters는 계정의 모든 ECS 클러스터 ARN을 반환합니다.
+func listClusters(ctx context.Context, client ECSAPI) ([]string, error) {
+	var clusters []string
+	input := &ecs.ListClustersInput{}
+	for {
+		output, err := client.ListClusters(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ECS clusters: %w", err)
+		}
+		clusters = append(clusters, output.ClusterArns...)
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return clusters, nil
+}
+
+func listServices(ctx context.Context, client ECSAPI, clusterName string) ([]string, error) {
 	var services []string
 	var nextToken *string
 
 	for {
-		output, err := client.ListServices(context.TODO(), &ecs.ListServicesInput{
+		output, err := client.ListServices(ctx, &ecs.ListServicesInput{
 			Cluster:    aws.String(clusterName),
 			NextToken:  nextToken,
 			MaxResults: aws.Int32(10),
@@ -162,17 +296,65 @@ func listServices(client *ecs.Client, clusterName string) ([]string, error) {
 	return services, nil
 }
 
-// Helper function to check if tags match
-func (e *ECSManager) matchTags(resourceTags []models.ResourceTag, awsTags []types.Tag) bool {
-	tagMap := make(map[string]string)
-	for _, tag := range awsTags {
-		tagMap[*tag.Key] = *tag.Value
+// ecsTags는 ECS 태그 목록을 키-값 맵으로 변환합니다.
+func ecsTags(tags []types.Tag) map[string]string {
+	return tagMap(tags, func(tag types.Tag) (*string, *string) { return tag.Key, tag.Value })
+}
+
+// GetStates는 클러스터별로 모든 서비스의 runningCount와 desiredCount를 비교하여 공통 상태 값을 반환합니다.
+// 모든 서비스가 desiredCount에 도달하면 desiredCount 합계에 따라 running 또는 stopped, 그 외에는 pending입니다.
+// 서비스가 없는 클러스터는 기다릴 대상이 없으므로 제외됩니다.
+func (e *ECSManager) GetStates(ctx context.Context, clusterNames []string) (map[string]string, error) {
+	states := make(map[string]string)
+	for _, clusterName := range clusterNames {
+		serviceNames, err := listServices(ctx, e.client, clusterName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services: %w", err)
+		}
+		if len(serviceNames) == 0 {
+			continue
+		}
+
+		services, err := describeServices(ctx, e.client, clusterName, serviceNames)
+		if err != nil {
+			return nil, err
+		}
+
+		var desired, running int32
+		settled := true
+		for _, service := range services {
+			desired += service.DesiredCount
+			running += service.RunningCount
+			if service.RunningCount != service.DesiredCount {
+				settled = false
+			}
+		}
+
+		switch {
+		case !settled:
+			states[clusterName] = StatePending
+		case desired == 0 && running == 0:
+			states[clusterName] = StateStopped
+		default:
+			states[clusterName] = StateRunning
+		}
 	}
+	return states, nil
+}
 
-	for _, resourceTag := range resourceTags {
-		if value, exists := tagMap[resourceTag.Key]; !exists || value != resourceTag.Value {
-			return false
+// describeServices는 클러스터의 서비스 정보를 조회합니다. DescribeServices는 한 번에 최대 10개의 서비스를 조회할 수 있으므로 나누어 호출합니다.
+func describeServices(ctx context.Context, client ECSAPI, clusterName string, serviceNames []string) ([]types.Service, error) {
+	var services []types.Service
+	for start := 0; start < len(serviceNames); start += 10 {
+		end := min(start+10, len(serviceNames))
+		output, err := client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterName),
+			Services: serviceNames[start:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe services in cluster %s: %w", clusterName, err)
 		}
+		services = append(services, output.Services...)
 	}
-	return true
+	return services, nil
 }
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+)
+
+// ApplicationAutoScalingAPI는 ECS 서비스의 스케일링 대상을 중단/재개할 때 사용하는 클라이언트 메서드입니다.
+// *applicationautoscaling.Client가 구현합니다.
+type ApplicationAutoScalingAPI interface {
+	DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+}
+
+// scalingResourceID는 ECS 서비스의 Application Auto Scaling 리소스 ID (service/<cluster>/<service>) 를 반환합니다.
+// 클러스터와 서비스는 ARN 또는 이름 모두 사용할 수 있습니다.
+func scalingResourceID(clusterName, serviceName string) string {
+	return fmt.Sprintf("service/%s/%s", lastARNSegment(clusterName), lastARNSegment(serviceName))
+}
+
+// lastARNSegment는 ARN의 마지막 '/' 이후 부분 (리소스 이름) 을 반환합니다.
+func lastARNSegment(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// describeScalableTarget은 ECS 서비스의 DesiredCount 스케일링 대상을 조회합니다. 대상이 없으면 nil을 반환합니다.
+func describeScalableTarget(ctx context.Context, client ApplicationAutoScalingAPI, resourceID string) (*types.ScalableTarget, error) {
+	output, err := client.DescribeScalableTargets(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  types.ServiceNamespaceEcs,
+		ResourceIds:       []string{resourceID},
+		ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe scalable target %s: %w", resourceID, err)
+	}
+	if len(output.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	return &output.ScalableTargets[0], nil
+}
+
+// suspendScaling은 스케일링 대상의 최소/최대 용량을 0으로 설정하고 스케일링 활동을 중단하여
+// 중지된 서비스가 Auto Scaling 정책에 의해 다시 늘어나지 않도록 합니다.
+func suspendScaling(ctx context.Context, client ApplicationAutoScalingAPI, resourceID string) error {
+	_, err := client.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  types.ServiceNamespaceEcs,
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+		MinCapacity:       aws.Int32(0),
+		MaxCapacity:       aws.Int32(0),
+		SuspendedState:    scalingSuspendedState(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to suspend scalable target %s: %w", resourceID, err)
+	}
+	log.Printf("Suspended Application Auto Scaling for %s", resourceID)
+	return nil
+}
+
+// resumeScaling은 저장된 최소/최대 용량을 복원하고 스케일링 활동을 재개합니다.
+// 저장된 용량이 없으면 현재 용량을 유지한 채 스케일링 활동만 재개합니다.
+func resumeScaling(ctx context.Context, client ApplicationAutoScalingAPI, resourceID string, minCapacity, maxCapacity *int32) error {
+	_, err := client.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  types.ServiceNamespaceEcs,
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+		MinCapacity:       minCapacity,
+		MaxCapacity:       maxCapacity,
+		SuspendedState:    scalingSuspendedState(false),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resume scalable target %s: %w", resourceID, err)
+	}
+	log.Printf("Resumed Application Auto Scaling for %s", resourceID)
+	return nil
+}
+
+func scalingSuspendedState(suspended bool) *types.SuspendedState {
+	return &types.SuspendedState{
+		DynamicScalingInSuspended:  aws.Bool(suspended),
+		DynamicScalingOutSuspended: aws.Bool(suspended),
+		ScheduledScalingSuspended:  aws.Bool(suspended),
+	}
+}
+
+// isSuspendedTarget은 스케일링 대상이 suspendScaling으로 중단된 상태 (최소/최대 0) 인지 확인합니다.
+func isSuspendedTarget(target *types.ScalableTarget) bool {
+	return aws.ToInt32(target.MinCapacity) == 0 && aws.ToInt32(target.MaxCapacity) == 0
+}
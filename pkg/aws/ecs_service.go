@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "ECS_SERVICE",
+		DisplayName: "ECS Service",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewECSServiceManager(NewECSManager(apis.ECS, apis.Scaling, store))
+		},
+	})
+}
+
+// ECSServiceManager는 클러스터 전체가 아닌 태그가 일치하는 ECS 서비스만 시작/중지합니다.
+// 리소스 ID는 서비스 ARN이며, 여러 클러스터의 서비스를 하나의 그룹에 포함할 수 있습니다.
+// 용량 저장과 Auto Scaling 처리는 ECSManager와 동일한 방식을 사용합니다.
+type ECSServiceManager struct {
+	ecs *ECSManager
+}
+
+func NewECSServiceManager(ecsManager *ECSManager) *ECSServiceManager {
+	return &ECSServiceManager{ecs: ecsManager}
+}
+
+// WithDefaultDesiredCount는 저장된 용량이 없는 서비스를 count로 시작하는 매니저 복사본을 반환합니다.
+func (m *ECSServiceManager) WithDefaultDesiredCount(count int32) AWSResourceManager {
+	return &ECSServiceManager{ecs: m.ecs.withDefaultDesiredCount(count)}
+}
+
+func (m *ECSServiceManager) Start(ctx context.Context, serviceArns []string) error {
+	for _, serviceArn := range serviceArns {
+		clusterArn, err := serviceClusterArn(serviceArn)
+		if err != nil {
+			return err
+		}
+		log.Printf("Starting ECS service: %s", serviceArn)
+		if err := m.ecs.startService(ctx, clusterArn, serviceArn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *ECSServiceManager) Stop(ctx context.Context, serviceArns []string) error {
+	for _, serviceArn := range serviceArns {
+		clusterArn, err := serviceClusterArn(serviceArn)
+		if err != nil {
+			return err
+		}
+		log.Printf("Stopping ECS service: %s", serviceArn)
+		if err := m.ecs.stopService(ctx, clusterArn, serviceArn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByTags는 모든 클러스터의 서비스 중 태그가 일치하는 서비스의 ARN을 반환합니다.
+func (m *ECSServiceManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	log.Printf("Retrieving ECS services with tags: %v", resourceTags)
+	matcher := NewTagMatcher(resourceTags)
+
+	clusters, err := listClusters(ctx, m.ecs.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingServices []string
+	for _, clusterArn := range clusters {
+		serviceArns, err := listServices(ctx, m.ecs.client, clusterArn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services in cluster %s: %w", clusterArn, err)
+		}
+
+		for _, serviceArn := range serviceArns {
+			tagOutput, err := m.ecs.client.ListTagsForResource(ctx, &ecs.ListTagsForResourceInput{
+				ResourceArn: &serviceArn,
+			})
+			if err != nil {
+				log.Printf("failed to get tags for service %s: %v", serviceArn, err)
+				continue
+			}
+
+			if matcher.Match(ecsTags(tagOutput.Tags)) {
+				matchingServices = append(matchingServices, serviceArn)
+			}
+		}
+	}
+
+	log.Printf("Matching ECS services: %v", matchingServices)
+	return matchingServices, nil
+}
+
+// GetStates는 서비스별로 runningCount가 desiredCount에 도달했는지 비교하여 공통 상태 값을 반환합니다.
+func (m *ECSServiceManager) GetStates(ctx context.Context, serviceArns []string) (map[string]string, error) {
+	// DescribeServices는 클러스터 단위로 호출해야 하므로 서비스를 클러스터별로 묶음
+	byCluster := make(map[string][]string)
+	var clusterOrder []string
+	for _, serviceArn := range serviceArns {
+		clusterArn, err := serviceClusterArn(serviceArn)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := byCluster[clusterArn]; !exists {
+			clusterOrder = append(clusterOrder, clusterArn)
+		}
+		byCluster[clusterArn] = append(byCluster[clusterArn], serviceArn)
+	}
+
+	states := make(map[string]string)
+	for _, clusterArn := range clusterOrder {
+		services, err := describeServices(ctx, m.ecs.client, clusterArn, byCluster[clusterArn])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, service := range services {
+			if service.ServiceArn == nil {
+				continue
+			}
+			switch {
+			case service.RunningCount != service.DesiredCount:
+				states[*service.ServiceArn] = StatePending
+			case service.DesiredCount == 0:
+				states[*service.ServiceArn] = StateStopped
+			default:
+				states[*service.ServiceArn] = StateRunning
+			}
+		}
+	}
+	return states, nil
+}
+
+// serviceClusterArn은 서비스 ARN (arn:aws:ecs:<region>:<account>:service/<cluster>/<service>) 에서 클러스터 ARN을 구합니다.
+// 클러스터 이름이 포함되지 않은 이전 형식의 서비스 ARN은 지원하지 않습니다.
+func serviceClusterArn(serviceArn string) (string, error) {
+	prefix, resource, ok := strings.Cut(serviceArn, ":service/")
+	if !ok {
+		return "", fmt.Errorf("invalid ECS service ARN %q", serviceArn)
+	}
+	clusterName, _, ok := strings.Cut(resource, "/")
+	if !ok || clusterName == "" {
+		return "", fmt.Errorf("ECS service ARN %q does not include the cluster name (long ARN format required)", serviceArn)
+	}
+	return prefix + ":cluster/" + clusterName, nil
+}
@@ -0,0 +1,141 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+)
+
+type autoScalingGroup struct {
+	minSize, maxSize, desiredCapacity int32
+	instances                         int32 // InService 인스턴스 수
+	remaining                         int   // 인스턴스 수가 희망 용량에 도달하기까지 남은 조회 횟수
+	tags                              map[string]string
+}
+
+// AddAutoScalingGroup은 희망 용량만큼 InService 인스턴스가 있는 Auto Scaling 그룹을 추가하고 ARN을 반환합니다.
+func (c *Cloud) AddAutoScalingGroup(name string, minSize, maxSize, desiredCapacity int32, tags map[string]string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.asgs[name]; !exists {
+		c.asgOrder = append(c.asgOrder, name)
+	}
+	c.asgs[name] = &autoScalingGroup{
+		minSize:         minSize,
+		maxSize:         maxSize,
+		desiredCapacity: desiredCapacity,
+		instances:       desiredCapacity,
+		tags:            tags,
+	}
+	return c.autoScalingGroupArn(name)
+}
+
+// AutoScalingGroup은 그룹의 최소/최대/희망 용량과 InService 인스턴스 수를 반환합니다.
+func (c *Cloud) AutoScalingGroup(name string) (minSize, maxSize, desiredCapacity, instances int32, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	group, exists := c.asgs[name]
+	if !exists {
+		return 0, 0, 0, 0, false
+	}
+	return group.minSize, group.maxSize, group.desiredCapacity, group.instances, true
+}
+
+// DescribeAutoScalingGroups는 AutoScalingGroupNames (비어 있으면 모든 그룹) 의 그룹을 한 페이지로 반환합니다.
+// 없는 그룹은 결과에서 빠지며, 조회한 그룹의 인스턴스 수 변화를 한 단계 진행합니다.
+func (c *Cloud) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeAutoScalingGroups"); err != nil {
+		return nil, err
+	}
+	if len(params.Filters) > 0 {
+		return nil, apiError("ValidationError", "filters are not supported")
+	}
+
+	output := &autoscaling.DescribeAutoScalingGroupsOutput{}
+	for _, name := range c.asgOrder {
+		if len(params.AutoScalingGroupNames) > 0 && !slices.Contains(params.AutoScalingGroupNames, name) {
+			continue
+		}
+		group := c.asgs[name]
+		group.observe()
+
+		var instances []types.Instance
+		for i := int32(0); i < group.instances; i++ {
+			instances = append(instances, types.Instance{
+				InstanceId:     awssdk.String(fmt.Sprintf("i-%s-%d", name, i)),
+				LifecycleState: types.LifecycleStateInService,
+			})
+		}
+		var tags []types.TagDescription
+		for key, value := range group.tags {
+			tags = append(tags, types.TagDescription{Key: awssdk.String(key), Value: awssdk.String(value)})
+		}
+		output.AutoScalingGroups = append(output.AutoScalingGroups, types.AutoScalingGroup{
+			AutoScalingGroupName: awssdk.String(name),
+			AutoScalingGroupARN:  awssdk.String(c.autoScalingGroupArn(name)),
+			MinSize:              awssdk.Int32(group.minSize),
+			MaxSize:              awssdk.Int32(group.maxSize),
+			DesiredCapacity:      awssdk.Int32(group.desiredCapacity),
+			Instances:            instances,
+			Tags:                 tags,
+		})
+	}
+	return output, nil
+}
+
+// UpdateAutoScalingGroup은 그룹의 최소/최대/희망 용량 중 지정한 값을 바꿉니다.
+// 인스턴스 수는 SettleAfter번 조회한 뒤 희망 용량이 되며, 최소 <= 희망 <= 최대가 아니면 에러를 반환합니다.
+func (c *Cloud) UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	name := awssdk.ToString(params.AutoScalingGroupName)
+	group, exists := c.asgs[name]
+	if !exists {
+		return nil, apiError("ValidationError", "AutoScalingGroup name not found - %s", name)
+	}
+
+	minSize, maxSize, desiredCapacity := group.minSize, group.maxSize, group.desiredCapacity
+	if params.MinSize != nil {
+		minSize = *params.MinSize
+	}
+	if params.MaxSize != nil {
+		maxSize = *params.MaxSize
+	}
+	if params.DesiredCapacity != nil {
+		desiredCapacity = *params.DesiredCapacity
+	}
+	if minSize > desiredCapacity || desiredCapacity > maxSize {
+		return nil, apiError("ValidationError", "desired capacity %d must be between min size %d and max size %d", desiredCapacity, minSize, maxSize)
+	}
+	if err := c.call("UpdateAutoScalingGroup", fmt.Sprintf("%s=%d/%d/%d", name, minSize, maxSize, desiredCapacity)); err != nil {
+		return nil, err
+	}
+
+	group.minSize, group.maxSize, group.desiredCapacity = minSize, maxSize, desiredCapacity
+	group.remaining = c.SettleAfter
+	if group.remaining == 0 {
+		group.instances = desiredCapacity
+	}
+	return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+}
+
+// observe는 그룹 조회 한 번을 반영합니다.
+func (g *autoScalingGroup) observe() {
+	if g.remaining == 0 {
+		return
+	}
+	g.remaining--
+	if g.remaining == 0 {
+		g.instances = g.desiredCapacity
+	}
+}
+
+func (c *Cloud) autoScalingGroupArn(name string) string {
+	return fmt.Sprintf("arn:aws:autoscaling:%s:%s:autoScalingGroup:00000000-0000-0000-0000-000000000000:autoScalingGroupName/%s", c.Region, c.AccountID, name)
+}
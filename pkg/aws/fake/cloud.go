@@ -0,0 +1,155 @@
+// Package fake는 테스트에서 AWS 대신 사용할 수 있는 메모리 기반 가짜 클라우드를 제공합니다.
+// Cloud는 한 리전의 태그가 붙은 EC2 인스턴스와 Auto Scaling 그룹, ECS 클러스터/서비스와 Auto Scaling 대상, RDS 인스턴스/클러스터를 흉내 내며
+// aws.ClientAPIs의 모든 클라이언트 인터페이스를 구현합니다.
+//
+//	clouds := fake.NewClouds()
+//	clouds.Region("us-east-1").AddInstance("i-0123", false, map[string]string{"env": "dev"})
+//	client := aws.NewAWSClientFromAPIs("us-east-1", nil, clouds.APIs)
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+)
+
+// DefaultAccountID는 가짜 리소스의 ARN에 사용하는 계정 ID입니다.
+const DefaultAccountID = "123456789012"
+
+// Cloud는 한 리전의 가짜 AWS 리소스입니다. 모든 메서드는 여러 고루틴에서 동시에 호출할 수 있습니다.
+type Cloud struct {
+	Region    string
+	AccountID string
+	// SettleAfter는 시작/중지한 리소스가 전환 상태 (pending, starting 등) 에서 목표 상태가 되기까지의 상태 조회 횟수입니다.
+	// 0이면 시작/중지 즉시 목표 상태가 됩니다.
+	SettleAfter int
+
+	mu          sync.Mutex
+	instances   map[string]*instance
+	clusters    map[string]*cluster // 클러스터 ARN별
+	services    map[string]*service // 서비스 ARN별
+	targets     map[string]*scalableTarget
+	dbInstances map[string]*dbInstance
+	dbClusters  map[string]*dbCluster
+	asgs        map[string]*autoScalingGroup
+	failures    map[string]error
+	calls       []string
+
+	// 조회 결과를 추가한 순서대로 반환하기 위한 ID 목록
+	instanceOrder, clusterOrder, dbInstanceOrder, dbClusterOrder, asgOrder []string
+}
+
+// NewCloud는 region의 빈 가짜 클라우드를 생성합니다.
+func NewCloud(region string) *Cloud {
+	return &Cloud{
+		Region:      region,
+		AccountID:   DefaultAccountID,
+		instances:   make(map[string]*instance),
+		clusters:    make(map[string]*cluster),
+		services:    make(map[string]*service),
+		targets:     make(map[string]*scalableTarget),
+		dbInstances: make(map[string]*dbInstance),
+		dbClusters:  make(map[string]*dbCluster),
+		asgs:        make(map[string]*autoScalingGroup),
+		failures:    make(map[string]error),
+	}
+}
+
+// APIs는 이 클라우드를 사용하는 서비스 클라이언트를 반환합니다.
+func (c *Cloud) APIs() aws.ClientAPIs {
+	return aws.ClientAPIs{EC2: c, ECS: c, Scaling: c, RDS: c, RDSCluster: c, AutoScaling: c}
+}
+
+// FailOn은 operation (예: "StopInstances", "StartDBInstance") 호출이 err를 반환하도록 합니다. err가 nil이면 해제합니다.
+func (c *Cloud) FailOn(operation string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		delete(c.failures, operation)
+		return
+	}
+	c.failures[operation] = err
+}
+
+// Calls는 지금까지 호출된 상태 변경 작업을 "작업 대상" 형식으로 호출 순서대로 반환합니다 (예: "StartInstances i-0123").
+func (c *Cloud) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.calls...)
+}
+
+// call은 작업 호출을 기록하고 FailOn으로 지정한 에러를 반환합니다. c.mu를 잡은 상태에서 호출해야 합니다.
+func (c *Cloud) call(operation string, targets ...string) error {
+	if err, exists := c.failures[operation]; exists {
+		return err
+	}
+	c.calls = append(c.calls, operation+" "+strings.Join(targets, ","))
+	return nil
+}
+
+// fail은 조회 작업에 FailOn으로 지정한 에러가 있으면 반환합니다. c.mu를 잡은 상태에서 호출해야 합니다.
+func (c *Cloud) fail(operation string) error {
+	return c.failures[operation]
+}
+
+// apiError는 AWS API 에러 코드를 포함한 에러를 반환합니다.
+func apiError(code, format string, args ...interface{}) error {
+	return fmt.Errorf("api error %s: %s", code, fmt.Sprintf(format, args...))
+}
+
+// transition은 시작/중지 후 전환 상태에서 목표 상태로 바뀌는 리소스 상태입니다.
+type transition struct {
+	state     string
+	target    string
+	remaining int
+}
+
+// begin은 전환 상태로 바꾸고, steps번 조회한 뒤 목표 상태가 되도록 합니다. steps가 0이면 바로 목표 상태가 됩니다.
+func (t *transition) begin(transitional, target string, steps int) {
+	if steps <= 0 {
+		t.state, t.target, t.remaining = target, target, 0
+		return
+	}
+	t.state, t.target, t.remaining = transitional, target, steps
+}
+
+// observe는 상태 조회 한 번을 반영합니다.
+func (t *transition) observe() {
+	if t.remaining == 0 {
+		return
+	}
+	t.remaining--
+	if t.remaining == 0 {
+		t.state = t.target
+	}
+}
+
+// Clouds는 리전별 가짜 클라우드입니다. APIs 메서드를 aws.NewAWSClientFromAPIs에 넘겨 사용합니다.
+type Clouds struct {
+	mu     sync.Mutex
+	clouds map[string]*Cloud
+}
+
+// NewClouds는 리전이 없는 Clouds를 생성합니다. 리전은 처음 요청될 때 만들어집니다.
+func NewClouds() *Clouds {
+	return &Clouds{clouds: make(map[string]*Cloud)}
+}
+
+// Region은 리전의 가짜 클라우드를 반환합니다. 없으면 새로 만듭니다.
+func (c *Clouds) Region(region string) *Cloud {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cloud, exists := c.clouds[region]
+	if !exists {
+		cloud = NewCloud(region)
+		c.clouds[region] = cloud
+	}
+	return cloud
+}
+
+// APIs는 리전의 가짜 클라우드를 사용하는 서비스 클라이언트를 반환합니다.
+func (c *Clouds) APIs(region string) aws.ClientAPIs {
+	return c.Region(region).APIs()
+}
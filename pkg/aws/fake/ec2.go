@@ -0,0 +1,210 @@
+package fake
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type instance struct {
+	transition
+	tags map[string]string
+}
+
+// AddInstance는 EC2 인스턴스를 추가합니다. running이 false이면 stopped 상태로 추가됩니다.
+func (c *Cloud) AddInstance(id string, running bool, tags map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state := string(types.InstanceStateNameStopped)
+	if running {
+		state = string(types.InstanceStateNameRunning)
+	}
+	if _, exists := c.instances[id]; !exists {
+		c.instanceOrder = append(c.instanceOrder, id)
+	}
+	c.instances[id] = &instance{transition: transition{state: state, target: state}, tags: tags}
+}
+
+// InstanceState는 EC2 인스턴스의 상태 이름 (running, pending, stopping, stopped) 을 반환합니다. 없으면 빈 문자열입니다.
+func (c *Cloud) InstanceState(id string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if inst, exists := c.instances[id]; exists {
+		return inst.state
+	}
+	return ""
+}
+
+// DescribeInstances는 InstanceIds와 태그 필터 (tag:<key>, tag-key, instance-state-name) 로 인스턴스를 조회합니다.
+// 결과는 한 페이지로 반환하며, 조회한 인스턴스의 전환 상태를 한 단계 진행합니다.
+func (c *Cloud) DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeInstances"); err != nil {
+		return nil, err
+	}
+
+	ids := c.instanceOrder
+	if len(params.InstanceIds) > 0 {
+		for _, id := range params.InstanceIds {
+			if _, exists := c.instances[id]; !exists {
+				return nil, apiError("InvalidInstanceID.NotFound", "the instance ID '%s' does not exist", id)
+			}
+		}
+		ids = params.InstanceIds
+	}
+
+	var instances []types.Instance
+	for _, id := range ids {
+		inst := c.instances[id]
+		matched, err := matchFilters(params.Filters, inst)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+		inst.observe()
+
+		var tags []types.Tag
+		for key, value := range inst.tags {
+			tags = append(tags, types.Tag{Key: awssdk.String(key), Value: awssdk.String(value)})
+		}
+		instances = append(instances, types.Instance{
+			InstanceId: awssdk.String(id),
+			State:      &types.InstanceState{Name: types.InstanceStateName(inst.state)},
+			Tags:       tags,
+		})
+	}
+
+	output := &ec2.DescribeInstancesOutput{}
+	if len(instances) > 0 {
+		output.Reservations = []types.Reservation{{Instances: instances}}
+	}
+	return output, nil
+}
+
+// StartInstances는 중지된 인스턴스를 pending을 거쳐 running으로 바꿉니다. 중지 중인 인스턴스는 에러를 반환합니다.
+func (c *Cloud) StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkInstances(params.InstanceIds, types.InstanceStateNameStopping); err != nil {
+		return nil, err
+	}
+	if err := c.call("StartInstances", params.InstanceIds...); err != nil {
+		return nil, err
+	}
+	c.applyInstances(params.InstanceIds, types.InstanceStateNameStopped, types.InstanceStateNamePending, types.InstanceStateNameRunning)
+	return &ec2.StartInstancesOutput{}, nil
+}
+
+// StopInstances는 실행 중인 인스턴스를 stopping을 거쳐 stopped로 바꿉니다. 시작 중인 인스턴스는 에러를 반환합니다.
+func (c *Cloud) StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkInstances(params.InstanceIds, types.InstanceStateNamePending); err != nil {
+		return nil, err
+	}
+	if err := c.call("StopInstances", params.InstanceIds...); err != nil {
+		return nil, err
+	}
+	c.applyInstances(params.InstanceIds, types.InstanceStateNameRunning, types.InstanceStateNameStopping, types.InstanceStateNameStopped)
+	return &ec2.StopInstancesOutput{}, nil
+}
+
+// checkInstances는 모든 인스턴스가 존재하고 blocked 상태가 아닌지 확인합니다.
+func (c *Cloud) checkInstances(ids []string, blocked types.InstanceStateName) error {
+	for _, id := range ids {
+		inst, exists := c.instances[id]
+		if !exists {
+			return apiError("InvalidInstanceID.NotFound", "the instance ID '%s' does not exist", id)
+		}
+		if inst.state == string(blocked) {
+			return apiError("IncorrectInstanceState", "the instance '%s' is in state %s", id, inst.state)
+		}
+	}
+	return nil
+}
+
+// applyInstances는 from 상태인 인스턴스를 전환 상태를 거쳐 목표 상태로 바꿉니다. 이미 목표 상태인 인스턴스는 그대로 둡니다.
+func (c *Cloud) applyInstances(ids []string, from, transitional, target types.InstanceStateName) {
+	for _, id := range ids {
+		inst := c.instances[id]
+		if inst.state == string(from) {
+			inst.begin(string(transitional), string(target), c.SettleAfter)
+		}
+	}
+}
+
+// matchFilters는 인스턴스가 모든 필터와 일치하는지 확인합니다. 필터 값의 * 와 ? 는 와일드카드입니다.
+func matchFilters(filters []types.Filter, inst *instance) (bool, error) {
+	for _, filter := range filters {
+		name := awssdk.ToString(filter.Name)
+		switch {
+		case strings.HasPrefix(name, "tag:"):
+			value, exists := inst.tags[strings.TrimPrefix(name, "tag:")]
+			if !exists || !matchAny(filter.Values, value) {
+				return false, nil
+			}
+		case name == "tag-key":
+			found := false
+			for key := range inst.tags {
+				if matchAny(filter.Values, key) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false, nil
+			}
+		case name == "instance-state-name":
+			if !matchAny(filter.Values, inst.state) {
+				return false, nil
+			}
+		default:
+			return false, apiError("InvalidParameterValue", "the filter '%s' is not supported by the fake", name)
+		}
+	}
+	return true, nil
+}
+
+// matchAny는 값이 필터 값 중 하나와 일치하는지 확인합니다.
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if filterPattern(pattern).MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterPattern은 EC2 필터 값을 정규식으로 변환합니다. \ 로 이스케이프한 문자와 마지막 \ 는 그대로 비교합니다.
+func filterPattern(pattern string) *regexp.Regexp {
+	var expr strings.Builder
+	expr.WriteString("^")
+	escaped := false
+	for _, ch := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+			expr.WriteString(regexp.QuoteMeta(string(ch)))
+		case ch == '\\':
+			escaped = true
+		case ch == '*':
+			expr.WriteString(".*")
+		case ch == '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	if escaped {
+		expr.WriteString(regexp.QuoteMeta(`\`))
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String())
+}
@@ -0,0 +1,335 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	scalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+type cluster struct {
+	name     string
+	tags     map[string]string
+	services []string // 서비스 ARN (추가한 순서)
+}
+
+type service struct {
+	clusterArn   string
+	name         string
+	tags         map[string]string
+	desiredCount int32
+	runningCount int32
+	remaining    int // runningCount가 desiredCount에 도달하기까지 남은 조회 횟수
+}
+
+type scalableTarget struct {
+	minCapacity, maxCapacity int32
+	suspended                bool
+}
+
+// AddCluster는 ECS 클러스터를 추가하고 ARN을 반환합니다.
+func (c *Cloud) AddCluster(name string, tags map[string]string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	arn := c.clusterArn(name)
+	if _, exists := c.clusters[arn]; !exists {
+		c.clusterOrder = append(c.clusterOrder, arn)
+		c.clusters[arn] = &cluster{name: name}
+	}
+	c.clusters[arn].tags = tags
+	return arn
+}
+
+// AddService는 클러스터에 desiredCount개의 태스크가 실행 중인 서비스를 추가하고 ARN을 반환합니다.
+// 클러스터가 없으면 태그 없이 만듭니다.
+func (c *Cloud) AddService(clusterName, serviceName string, desiredCount int32, tags map[string]string) string {
+	clusterArn := c.clusterArn(clusterName)
+	if _, exists := c.clusterByID(clusterName); !exists {
+		c.AddCluster(clusterName, nil)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	arn := c.serviceArn(clusterName, serviceName)
+	if _, exists := c.services[arn]; !exists {
+		c.clusters[clusterArn].services = append(c.clusters[clusterArn].services, arn)
+	}
+	c.services[arn] = &service{
+		clusterArn:   clusterArn,
+		name:         serviceName,
+		tags:         tags,
+		desiredCount: desiredCount,
+		runningCount: desiredCount,
+	}
+	return arn
+}
+
+// AddScalableTarget은 서비스의 DesiredCount에 대한 Application Auto Scaling 대상을 추가합니다.
+func (c *Cloud) AddScalableTarget(clusterName, serviceName string, minCapacity, maxCapacity int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.targets[scalingResourceID(clusterName, serviceName)] = &scalableTarget{minCapacity: minCapacity, maxCapacity: maxCapacity}
+}
+
+// Service는 서비스의 DesiredCount와 실행 중인 태스크 수를 반환합니다. 서비스 ID는 ARN 또는 이름입니다.
+func (c *Cloud) Service(clusterName, serviceID string) (desiredCount, runningCount int32, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	svc, exists := c.serviceByID(c.clusterArn(clusterName), serviceID)
+	if !exists {
+		return 0, 0, false
+	}
+	return svc.desiredCount, svc.runningCount, true
+}
+
+// ScalableTarget은 서비스의 Auto Scaling 대상의 최소/최대 용량과 스케일링 중단 여부를 반환합니다.
+func (c *Cloud) ScalableTarget(clusterName, serviceName string) (minCapacity, maxCapacity int32, suspended, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	target, exists := c.targets[scalingResourceID(clusterName, serviceName)]
+	if !exists {
+		return 0, 0, false, false
+	}
+	return target.minCapacity, target.maxCapacity, target.suspended, true
+}
+
+// ListClusters는 모든 클러스터 ARN을 한 페이지로 반환합니다.
+func (c *Cloud) ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("ListClusters"); err != nil {
+		return nil, err
+	}
+	return &ecs.ListClustersOutput{ClusterArns: append([]string(nil), c.clusterOrder...)}, nil
+}
+
+// ListServices는 클러스터의 서비스 ARN을 MaxResults (기본 10) 개씩 나누어 반환합니다.
+func (c *Cloud) ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("ListServices"); err != nil {
+		return nil, err
+	}
+	cl, exists := c.clusterByIDLocked(awssdk.ToString(params.Cluster))
+	if !exists {
+		return nil, apiError("ClusterNotFoundException", "cluster not found: %s", awssdk.ToString(params.Cluster))
+	}
+
+	start := 0
+	if params.NextToken != nil {
+		start, _ = strconv.Atoi(*params.NextToken)
+	}
+	pageSize := int(awssdk.ToInt32(params.MaxResults))
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	end := min(start+pageSize, len(cl.services))
+
+	output := &ecs.ListServicesOutput{ServiceArns: append([]string(nil), cl.services[start:end]...)}
+	if end < len(cl.services) {
+		output.NextToken = awssdk.String(strconv.Itoa(end))
+	}
+	return output, nil
+}
+
+// DescribeServices는 서비스의 DesiredCount와 RunningCount를 반환하고, 조회한 서비스의 태스크 수 변화를 한 단계 진행합니다.
+// 없는 서비스는 Failures에 포함합니다.
+func (c *Cloud) DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeServices"); err != nil {
+		return nil, err
+	}
+	if len(params.Services) > 10 {
+		return nil, apiError("InvalidParameterException", "services cannot contain more than 10 elements")
+	}
+	cl, exists := c.clusterByIDLocked(awssdk.ToString(params.Cluster))
+	if !exists {
+		return nil, apiError("ClusterNotFoundException", "cluster not found: %s", awssdk.ToString(params.Cluster))
+	}
+
+	output := &ecs.DescribeServicesOutput{}
+	for _, serviceID := range params.Services {
+		svc, exists := c.serviceByID(c.clusterArn(cl.name), serviceID)
+		if !exists {
+			output.Failures = append(output.Failures, types.Failure{Arn: awssdk.String(serviceID), Reason: awssdk.String("MISSING")})
+			continue
+		}
+		svc.observe()
+		output.Services = append(output.Services, types.Service{
+			ServiceArn:   awssdk.String(c.serviceArn(cl.name, svc.name)),
+			ServiceName:  awssdk.String(svc.name),
+			ClusterArn:   awssdk.String(svc.clusterArn),
+			DesiredCount: svc.desiredCount,
+			RunningCount: svc.runningCount,
+		})
+	}
+	return output, nil
+}
+
+// UpdateService는 서비스의 DesiredCount를 바꿉니다. 실행 중인 태스크 수는 SettleAfter번 조회한 뒤 DesiredCount가 됩니다.
+func (c *Cloud) UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cl, exists := c.clusterByIDLocked(awssdk.ToString(params.Cluster))
+	if !exists {
+		return nil, apiError("ClusterNotFoundException", "cluster not found: %s", awssdk.ToString(params.Cluster))
+	}
+	svc, exists := c.serviceByID(c.clusterArn(cl.name), awssdk.ToString(params.Service))
+	if !exists {
+		return nil, apiError("ServiceNotFoundException", "service not found: %s", awssdk.ToString(params.Service))
+	}
+	if err := c.call("UpdateService", fmt.Sprintf("%s=%d", svc.name, awssdk.ToInt32(params.DesiredCount))); err != nil {
+		return nil, err
+	}
+
+	if params.DesiredCount != nil {
+		svc.desiredCount = *params.DesiredCount
+		svc.remaining = c.SettleAfter
+		if svc.remaining == 0 {
+			svc.runningCount = svc.desiredCount
+		}
+	}
+	return &ecs.UpdateServiceOutput{}, nil
+}
+
+// ListTagsForResource는 클러스터 또는 서비스 ARN의 태그를 반환합니다.
+func (c *Cloud) ListTagsForResource(ctx context.Context, params *ecs.ListTagsForResourceInput, optFns ...func(*ecs.Options)) (*ecs.ListTagsForResourceOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("ListTagsForResource"); err != nil {
+		return nil, err
+	}
+
+	arn := awssdk.ToString(params.ResourceArn)
+	var tags map[string]string
+	if cl, exists := c.clusters[arn]; exists {
+		tags = cl.tags
+	} else if svc, exists := c.services[arn]; exists {
+		tags = svc.tags
+	} else {
+		return nil, apiError("InvalidParameterException", "resource not found: %s", arn)
+	}
+
+	output := &ecs.ListTagsForResourceOutput{}
+	for key, value := range tags {
+		output.Tags = append(output.Tags, types.Tag{Key: awssdk.String(key), Value: awssdk.String(value)})
+	}
+	return output, nil
+}
+
+// DescribeScalableTargets는 ResourceIds에 해당하는 ECS 서비스의 스케일링 대상을 반환합니다.
+func (c *Cloud) DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeScalableTargets"); err != nil {
+		return nil, err
+	}
+
+	output := &applicationautoscaling.DescribeScalableTargetsOutput{}
+	for _, resourceID := range params.ResourceIds {
+		target, exists := c.targets[resourceID]
+		if !exists {
+			continue
+		}
+		output.ScalableTargets = append(output.ScalableTargets, scalingtypes.ScalableTarget{
+			ServiceNamespace:  scalingtypes.ServiceNamespaceEcs,
+			ResourceId:        awssdk.String(resourceID),
+			ScalableDimension: scalingtypes.ScalableDimensionECSServiceDesiredCount,
+			MinCapacity:       awssdk.Int32(target.minCapacity),
+			MaxCapacity:       awssdk.Int32(target.maxCapacity),
+			SuspendedState:    suspendedState(target.suspended),
+		})
+	}
+	return output, nil
+}
+
+// RegisterScalableTarget은 스케일링 대상의 최소/최대 용량 (지정한 경우) 과 스케일링 중단 여부를 바꿉니다.
+func (c *Cloud) RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resourceID := awssdk.ToString(params.ResourceId)
+	if err := c.call("RegisterScalableTarget", resourceID); err != nil {
+		return nil, err
+	}
+
+	target, exists := c.targets[resourceID]
+	if !exists {
+		target = &scalableTarget{}
+		c.targets[resourceID] = target
+	}
+	if params.MinCapacity != nil {
+		target.minCapacity = *params.MinCapacity
+	}
+	if params.MaxCapacity != nil {
+		target.maxCapacity = *params.MaxCapacity
+	}
+	if params.SuspendedState != nil {
+		target.suspended = awssdk.ToBool(params.SuspendedState.DynamicScalingInSuspended)
+	}
+	return &applicationautoscaling.RegisterScalableTargetOutput{}, nil
+}
+
+// observe는 서비스 조회 한 번을 반영합니다.
+func (s *service) observe() {
+	if s.remaining == 0 {
+		return
+	}
+	s.remaining--
+	if s.remaining == 0 {
+		s.runningCount = s.desiredCount
+	}
+}
+
+func (c *Cloud) clusterArn(name string) string {
+	return fmt.Sprintf("arn:aws:ecs:%s:%s:cluster/%s", c.Region, c.AccountID, lastSegment(name))
+}
+
+func (c *Cloud) serviceArn(clusterName, serviceName string) string {
+	return fmt.Sprintf("arn:aws:ecs:%s:%s:service/%s/%s", c.Region, c.AccountID, clusterName, serviceName)
+}
+
+// clusterByID는 클러스터 이름 또는 ARN으로 클러스터를 찾습니다.
+func (c *Cloud) clusterByID(id string) (*cluster, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.clusterByIDLocked(id)
+}
+
+func (c *Cloud) clusterByIDLocked(id string) (*cluster, bool) {
+	cl, exists := c.clusters[c.clusterArn(id)]
+	return cl, exists
+}
+
+// serviceByID는 클러스터에서 서비스 이름 또는 ARN으로 서비스를 찾습니다.
+func (c *Cloud) serviceByID(clusterArn, id string) (*service, bool) {
+	cl, exists := c.clusters[clusterArn]
+	if !exists {
+		return nil, false
+	}
+	svc, exists := c.services[c.serviceArn(cl.name, lastSegment(id))]
+	return svc, exists
+}
+
+func scalingResourceID(clusterName, serviceName string) string {
+	return fmt.Sprintf("service/%s/%s", lastSegment(clusterName), lastSegment(serviceName))
+}
+
+func suspendedState(suspended bool) *scalingtypes.SuspendedState {
+	return &scalingtypes.SuspendedState{
+		DynamicScalingInSuspended:  awssdk.Bool(suspended),
+		DynamicScalingOutSuspended: awssdk.Bool(suspended),
+		ScheduledScalingSuspended:  awssdk.Bool(suspended),
+	}
+}
+
+// lastSegment는 ARN의 마지막 '/' 이후 부분 (리소스 이름) 을 반환합니다.
+func lastSegment(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
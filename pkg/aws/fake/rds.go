@@ -0,0 +1,266 @@
+package fake
+
+import (
+	"context"
+	"slices"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+type dbInstance struct {
+	transition
+	tags      map[string]string
+	clusterID string // 클러스터 멤버이면 DB 클러스터 ID
+}
+
+type dbCluster struct {
+	transition
+	tags map[string]string
+}
+
+// AddDBInstance는 RDS 인스턴스를 추가합니다. running이 false이면 stopped 상태로 추가됩니다.
+func (c *Cloud) AddDBInstance(id string, running bool, tags map[string]string) {
+	c.addDBInstance(id, running, tags, "")
+}
+
+// AddDBCluster는 DB 클러스터와 멤버 인스턴스를 추가합니다. running이 false이면 stopped 상태로 추가됩니다.
+func (c *Cloud) AddDBCluster(id string, running bool, tags map[string]string, members ...string) {
+	c.mu.Lock()
+	if _, exists := c.dbClusters[id]; !exists {
+		c.dbClusterOrder = append(c.dbClusterOrder, id)
+	}
+	state := dbStatus(running)
+	c.dbClusters[id] = &dbCluster{transition: transition{state: state, target: state}, tags: tags}
+	c.mu.Unlock()
+
+	for _, member := range members {
+		c.addDBInstance(member, running, tags, id)
+	}
+}
+
+func (c *Cloud) addDBInstance(id string, running bool, tags map[string]string, clusterID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.dbInstances[id]; !exists {
+		c.dbInstanceOrder = append(c.dbInstanceOrder, id)
+	}
+	state := dbStatus(running)
+	c.dbInstances[id] = &dbInstance{transition: transition{state: state, target: state}, tags: tags, clusterID: clusterID}
+}
+
+// DBInstanceStatus는 RDS 인스턴스의 상태 (available, starting, stopping, stopped) 를 반환합니다. 없으면 빈 문자열입니다.
+func (c *Cloud) DBInstanceStatus(id string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if db, exists := c.dbInstances[id]; exists {
+		return db.state
+	}
+	return ""
+}
+
+// DBClusterStatus는 DB 클러스터의 상태를 반환합니다. 없으면 빈 문자열입니다.
+func (c *Cloud) DBClusterStatus(id string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cluster, exists := c.dbClusters[id]; exists {
+		return cluster.state
+	}
+	return ""
+}
+
+// DescribeDBInstances는 DBInstanceIdentifier 또는 db-instance-id 필터로 인스턴스를 조회합니다.
+// 결과는 한 페이지로 반환하며, 조회한 인스턴스의 전환 상태를 한 단계 진행합니다.
+func (c *Cloud) DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeDBInstances"); err != nil {
+		return nil, err
+	}
+
+	ids, err := filterIDs(c.dbInstanceOrder, params.DBInstanceIdentifier, params.Filters, "db-instance-id")
+	if err != nil {
+		return nil, err
+	}
+	if params.DBInstanceIdentifier != nil && len(ids) == 0 {
+		return nil, apiError("DBInstanceNotFound", "DBInstance %s not found", *params.DBInstanceIdentifier)
+	}
+
+	output := &rds.DescribeDBInstancesOutput{}
+	for _, id := range ids {
+		db := c.dbInstances[id]
+		db.observe()
+		dbInstance := types.DBInstance{
+			DBInstanceIdentifier: awssdk.String(id),
+			DBInstanceStatus:     awssdk.String(db.state),
+			TagList:              rdsTags(db.tags),
+		}
+		if db.clusterID != "" {
+			dbInstance.DBClusterIdentifier = awssdk.String(db.clusterID)
+		}
+		output.DBInstances = append(output.DBInstances, dbInstance)
+	}
+	return output, nil
+}
+
+// StartDBInstance는 중지된 인스턴스를 starting을 거쳐 available로 바꿉니다.
+func (c *Cloud) StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := awssdk.ToString(params.DBInstanceIdentifier)
+	db, err := c.dbInstanceFor(id, "stopped")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.call("StartDBInstance", id); err != nil {
+		return nil, err
+	}
+	db.begin("starting", "available", c.SettleAfter)
+	return &rds.StartDBInstanceOutput{}, nil
+}
+
+// StopDBInstance는 실행 중인 인스턴스를 stopping을 거쳐 stopped로 바꿉니다.
+func (c *Cloud) StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := awssdk.ToString(params.DBInstanceIdentifier)
+	db, err := c.dbInstanceFor(id, "available")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.call("StopDBInstance", id); err != nil {
+		return nil, err
+	}
+	db.begin("stopping", "stopped", c.SettleAfter)
+	return &rds.StopDBInstanceOutput{}, nil
+}
+
+// DescribeDBClusters는 DBClusterIdentifier 또는 db-cluster-id 필터로 클러스터를 조회합니다.
+// 결과는 한 페이지로 반환하며, 조회한 클러스터의 전환 상태를 한 단계 진행합니다.
+func (c *Cloud) DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.fail("DescribeDBClusters"); err != nil {
+		return nil, err
+	}
+
+	ids, err := filterIDs(c.dbClusterOrder, params.DBClusterIdentifier, params.Filters, "db-cluster-id")
+	if err != nil {
+		return nil, err
+	}
+	if params.DBClusterIdentifier != nil && len(ids) == 0 {
+		return nil, apiError("DBClusterNotFoundFault", "DBCluster %s not found", *params.DBClusterIdentifier)
+	}
+
+	output := &rds.DescribeDBClustersOutput{}
+	for _, id := range ids {
+		cluster := c.dbClusters[id]
+		cluster.observe()
+		output.DBClusters = append(output.DBClusters, types.DBCluster{
+			DBClusterIdentifier: awssdk.String(id),
+			Status:              awssdk.String(cluster.state),
+			TagList:             rdsTags(cluster.tags),
+		})
+	}
+	return output, nil
+}
+
+// StartDBCluster는 중지된 클러스터와 멤버 인스턴스를 starting을 거쳐 available로 바꿉니다.
+func (c *Cloud) StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := awssdk.ToString(params.DBClusterIdentifier)
+	if err := c.changeDBCluster(id, "StartDBCluster", "stopped", "starting", "available"); err != nil {
+		return nil, err
+	}
+	return &rds.StartDBClusterOutput{}, nil
+}
+
+// StopDBCluster는 실행 중인 클러스터와 멤버 인스턴스를 stopping을 거쳐 stopped로 바꿉니다.
+func (c *Cloud) StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := awssdk.ToString(params.DBClusterIdentifier)
+	if err := c.changeDBCluster(id, "StopDBCluster", "available", "stopping", "stopped"); err != nil {
+		return nil, err
+	}
+	return &rds.StopDBClusterOutput{}, nil
+}
+
+// dbInstanceFor는 id 인스턴스가 존재하고 from 상태인지 확인합니다. 클러스터 멤버는 개별적으로 시작/중지할 수 없습니다.
+func (c *Cloud) dbInstanceFor(id, from string) (*dbInstance, error) {
+	db, exists := c.dbInstances[id]
+	if !exists {
+		return nil, apiError("DBInstanceNotFound", "DBInstance %s not found", id)
+	}
+	if db.clusterID != "" {
+		return nil, apiError("InvalidDBInstanceState", "DBInstance %s is a member of DB cluster %s", id, db.clusterID)
+	}
+	if db.state != from {
+		return nil, apiError("InvalidDBInstanceState", "DBInstance %s is not in %s state (%s)", id, from, db.state)
+	}
+	return db, nil
+}
+
+// changeDBCluster는 from 상태인 클러스터와 멤버 인스턴스를 전환 상태를 거쳐 target 상태로 바꿉니다.
+func (c *Cloud) changeDBCluster(id, operation, from, transitional, target string) error {
+	cluster, exists := c.dbClusters[id]
+	if !exists {
+		return apiError("DBClusterNotFoundFault", "DBCluster %s not found", id)
+	}
+	if cluster.state != from {
+		return apiError("InvalidDBClusterStateFault", "DBCluster %s is not in %s state (%s)", id, from, cluster.state)
+	}
+	if err := c.call(operation, id); err != nil {
+		return err
+	}
+
+	cluster.begin(transitional, target, c.SettleAfter)
+	for _, db := range c.dbInstances {
+		if db.clusterID == id {
+			db.begin(transitional, target, c.SettleAfter)
+		}
+	}
+	return nil
+}
+
+// filterIDs는 identifier 또는 name 필터에 해당하는 ID를 order 순서대로 반환합니다. 다른 필터는 지원하지 않습니다.
+func filterIDs(order []string, identifier *string, filters []types.Filter, name string) ([]string, error) {
+	var wanted []string
+	if identifier != nil {
+		wanted = append(wanted, *identifier)
+	}
+	for _, filter := range filters {
+		if awssdk.ToString(filter.Name) != name {
+			return nil, apiError("InvalidParameterValue", "unsupported filter: %s", awssdk.ToString(filter.Name))
+		}
+		wanted = append(wanted, filter.Values...)
+	}
+	if identifier == nil && len(filters) == 0 {
+		return order, nil
+	}
+
+	var ids []string
+	for _, id := range order {
+		if slices.Contains(wanted, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func rdsTags(tags map[string]string) []types.Tag {
+	var tagList []types.Tag
+	for key, value := range tags {
+		tagList = append(tagList, types.Tag{Key: awssdk.String(key), Value: awssdk.String(value)})
+	}
+	return tagList
+}
+
+func dbStatus(running bool) string {
+	if running {
+		return "available"
+	}
+	return "stopped"
+}
@@ -3,21 +3,38 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
-
-	"log"
 )
 
-// TODO : 현재 Start 작동 안함, GetbyTags시 리전 및 Account ID 종속되지 않게, 혹은 자동으로 받아오게 해야 함
+// RDSAPI는 RDSManager가 사용하는 RDS 클라이언트 메서드입니다. *rds.Client가 구현하며 테스트에서는 가짜 클라이언트로 대체할 수 있습니다.
+type RDSAPI interface {
+	DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+	StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error)
+}
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "RDS",
+		DisplayName: "RDS DB Instance",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewRDSManager(apis.RDS)
+		},
+	})
+}
 
 type RDSManager struct {
-	client *rds.Client
+	client RDSAPI
 }
 
-func NewRDSManager(client *rds.Client) *RDSManager {
+func NewRDSManager(client RDSAPI) *RDSManager {
 	return &RDSManager{
 		client: client,
 	}
@@ -57,9 +74,10 @@ func (r *RDSManager) Stop(ctx context.Context, dbInstanceIdentifiers []string) e
 
 func (r *RDSManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
 	log.Printf("Retrieving RDS instances with tags: %v", resourceTags)
+	matcher := NewTagMatcher(resourceTags)
 
-	// List all RDS instances
-	var allInstances []string
+	// DescribeDBInstances 응답에 포함된 TagList로 태그를 비교하므로 ARN을 직접 만들 필요가 없음
+	var allInstances, matchingInstances []string
 	input := &rds.DescribeDBInstancesInput{}
 	for {
 		output, err := r.client.DescribeDBInstances(ctx, input)
@@ -68,7 +86,20 @@ func (r *RDSManager) GetByTags(ctx context.Context, resourceTags []models.Resour
 		}
 
 		for _, dbInstance := range output.DBInstances {
-			allInstances = append(allInstances, *dbInstance.DBInstanceIdentifier)
+			instanceIdentifier := aws.ToString(dbInstance.DBInstanceIdentifier)
+			allInstances = append(allInstances, instanceIdentifier)
+
+			// Check if the instance's tags match the provided tags
+			if !matcher.Match(rdsTags(dbInstance.TagList)) {
+				continue
+			}
+			// 클러스터 멤버 인스턴스는 개별적으로 중지할 수 없으므로 RDS_CLUSTER 타입으로 처리해야 함
+			if dbInstance.DBClusterIdentifier != nil {
+				log.Printf("Skipping RDS instance %s: member of DB cluster %s (use RDS_CLUSTER)",
+					instanceIdentifier, aws.ToString(dbInstance.DBClusterIdentifier))
+				continue
+			}
+			matchingInstances = append(matchingInstances, instanceIdentifier)
 		}
 
 		if output.Marker == nil {
@@ -78,40 +109,52 @@ func (r *RDSManager) GetByTags(ctx context.Context, resourceTags []models.Resour
 	}
 
 	log.Printf("Retrieved all RDS instances: %v", allInstances)
+	log.Printf("Matching RDS instances: %v", matchingInstances)
+	return matchingInstances, nil
+}
 
-	var matchingInstances []string
-	for _, instanceIdentifier := range allInstances {
-		// Retrieve tags for the current RDS instance
-		tagInput := &rds.ListTagsForResourceInput{
-			ResourceName: aws.String(fmt.Sprintf("arn:aws:rds:ap-northeast-2:593634833876:db:%s", instanceIdentifier)),
-		}
-		tagOutput, err := r.client.ListTagsForResource(ctx, tagInput)
+// rdsTags는 RDS 태그 목록을 키-값 맵으로 변환합니다.
+func rdsTags(tags []types.Tag) map[string]string {
+	return tagMap(tags, func(tag types.Tag) (*string, *string) { return tag.Key, tag.Value })
+}
+
+// GetStates는 RDS 인스턴스의 현재 상태를 공통 상태 값으로 반환합니다.
+func (r *RDSManager) GetStates(ctx context.Context, dbInstanceIdentifiers []string) (map[string]string, error) {
+	states := make(map[string]string)
+	input := &rds.DescribeDBInstancesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("db-instance-id"), Values: dbInstanceIdentifiers},
+		},
+	}
+	for {
+		output, err := r.client.DescribeDBInstances(ctx, input)
 		if err != nil {
-			log.Printf("failed to get tags for RDS instance %s: %v", instanceIdentifier, err)
-			continue
+			return nil, fmt.Errorf("failed to describe RDS instances: %w", err)
 		}
 
-		// Check if the instance's tags match the provided tags
-		if r.matchTags(resourceTags, tagOutput.TagList) {
-			matchingInstances = append(matchingInstances, instanceIdentifier)
+		for _, dbInstance := range output.DBInstances {
+			states[*dbInstance.DBInstanceIdentifier] = rdsState(aws.ToString(dbInstance.DBInstanceStatus))
 		}
-	}
 
-	log.Printf("Matching RDS instances: %v", matchingInstances)
-	return matchingInstances, nil
-}
-
-// Helper function to check if tags match
-func (r *RDSManager) matchTags(resourceTags []models.ResourceTag, awsTags []types.Tag) bool {
-	tagMap := make(map[string]string)
-	for _, tag := range awsTags {
-		tagMap[*tag.Key] = *tag.Value
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
 	}
+	return states, nil
+}
 
-	for _, resourceTag := range resourceTags {
-		if value, exists := tagMap[resourceTag.Key]; !exists || value != resourceTag.Value {
-			return false
-		}
+// rdsState는 RDS 상태 값 (DBInstanceStatus, DB 클러스터 Status) 을 공통 상태 값으로 변환합니다.
+func rdsState(status string) string {
+	switch status {
+	case "available":
+		return StateRunning
+	case "stopped":
+		return StateStopped
+	case "starting", "stopping", "rebooting", "modifying", "backing-up", "configuring-enhanced-monitoring",
+		"configuring-log-exports", "upgrading", "maintenance", "renaming", "resetting-master-credentials":
+		return StatePending
+	default:
+		return StateUnknown
 	}
-	return true
 }
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// RDSClusterAPI는 RDSClusterManager가 사용하는 RDS 클라이언트 메서드입니다. *rds.Client가 구현합니다.
+type RDSClusterAPI interface {
+	DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+	StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error)
+}
+
+func init() {
+	RegisterResourceType(ResourceType{
+		Name:        "RDS_CLUSTER",
+		DisplayName: "RDS DB Cluster",
+		Actions:     startStop,
+		StateQuery:  true,
+		NewManager: func(apis ClientAPIs, store CapacityStore) AWSResourceManager {
+			return NewRDSClusterManager(apis.RDSCluster)
+		},
+	})
+}
+
+// RDSClusterManager는 Aurora 등 DB 클러스터를 클러스터 단위로 시작/중지합니다.
+// 클러스터 멤버 인스턴스는 개별적으로 중지할 수 없으므로 RDSManager 대신 이 매니저를 사용해야 합니다.
+type RDSClusterManager struct {
+	client RDSClusterAPI
+}
+
+func NewRDSClusterManager(client RDSClusterAPI) *RDSClusterManager {
+	return &RDSClusterManager{
+		client: client,
+	}
+}
+
+func (r *RDSClusterManager) Start(ctx context.Context, clusterIdentifiers []string) error {
+	for _, cluster := range clusterIdentifiers {
+		log.Printf("Starting RDS cluster: %s", cluster)
+
+		_, err := r.client.StartDBCluster(ctx, &rds.StartDBClusterInput{
+			DBClusterIdentifier: aws.String(cluster),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to start RDS cluster %s: %w", cluster, err)
+		}
+
+		log.Printf("Successfully started RDS cluster: %s", cluster)
+	}
+	return nil
+}
+
+func (r *RDSClusterManager) Stop(ctx context.Context, clusterIdentifiers []string) error {
+	for _, cluster := range clusterIdentifiers {
+		log.Printf("Stopping RDS cluster: %s", cluster)
+
+		_, err := r.client.StopDBCluster(ctx, &rds.StopDBClusterInput{
+			DBClusterIdentifier: aws.String(cluster),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to stop RDS cluster %s: %w", cluster, err)
+		}
+
+		log.Printf("Successfully stopped RDS cluster: %s", cluster)
+	}
+	return nil
+}
+
+func (r *RDSClusterManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	log.Printf("Retrieving RDS clusters with tags: %v", resourceTags)
+	matcher := NewTagMatcher(resourceTags)
+
+	var matchingClusters []string
+	input := &rds.DescribeDBClustersInput{}
+	for {
+		output, err := r.client.DescribeDBClusters(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe RDS clusters: %w", err)
+		}
+
+		for _, cluster := range output.DBClusters {
+			if matcher.Match(rdsTags(cluster.TagList)) {
+				matchingClusters = append(matchingClusters, aws.ToString(cluster.DBClusterIdentifier))
+			}
+		}
+
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	log.Printf("Matching RDS clusters: %v", matchingClusters)
+	return matchingClusters, nil
+}
+
+// GetStates는 DB 클러스터의 현재 상태를 공통 상태 값으로 반환합니다.
+func (r *RDSClusterManager) GetStates(ctx context.Context, clusterIdentifiers []string) (map[string]string, error) {
+	states := make(map[string]string)
+	input := &rds.DescribeDBClustersInput{
+		Filters: []types.Filter{
+			{Name: aws.String("db-cluster-id"), Values: clusterIdentifiers},
+		},
+	}
+	for {
+		output, err := r.client.DescribeDBClusters(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe RDS clusters: %w", err)
+		}
+
+		for _, cluster := range output.DBClusters {
+			states[aws.ToString(cluster.DBClusterIdentifier)] = rdsState(aws.ToString(cluster.Status))
+		}
+
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+	return states, nil
+}
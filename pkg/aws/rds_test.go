@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// fakeRDSClient는 DescribeDBInstances 응답을 페이지 단위로 돌려주는 가짜 RDS 클라이언트입니다.
+type fakeRDSClient struct {
+	pages   [][]types.DBInstance
+	err     error
+	calls   int
+	started []string
+	stopped []string
+}
+
+func (f *fakeRDSClient) DescribeDBInstances(_ context.Context, params *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	page := 0
+	if params.Marker != nil {
+		page = len(*params.Marker)
+	}
+	output := &rds.DescribeDBInstancesOutput{}
+	if page < len(f.pages) {
+		output.DBInstances = f.pages[page]
+	}
+	if page+1 < len(f.pages) {
+		// 페이지 번호를 마커 길이로 표현
+		marker := aws.ToString(params.Marker) + "m"
+		output.Marker = &marker
+	}
+	return output, nil
+}
+
+func (f *fakeRDSClient) StartDBInstance(_ context.Context, params *rds.StartDBInstanceInput, _ ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	f.started = append(f.started, aws.ToString(params.DBInstanceIdentifier))
+	return &rds.StartDBInstanceOutput{}, nil
+}
+
+func (f *fakeRDSClient) StopDBInstance(_ context.Context, params *rds.StopDBInstanceInput, _ ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	f.stopped = append(f.stopped, aws.ToString(params.DBInstanceIdentifier))
+	return &rds.StopDBInstanceOutput{}, nil
+}
+
+func dbInstance(id, arn, status string, tags map[string]string) types.DBInstance {
+	instance := types.DBInstance{
+		DBInstanceIdentifier: aws.String(id),
+		DBInstanceArn:        aws.String(arn),
+		DBInstanceStatus:     aws.String(status),
+	}
+	for key, value := range tags {
+		instance.TagList = append(instance.TagList, types.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return instance
+}
+
+func TestRDSGetByTagsMatchesTagListInAnyAccountAndRegion(t *testing.T) {
+	client := &fakeRDSClient{
+		pages: [][]types.DBInstance{
+			{
+				dbInstance("dev-db", "arn:aws:rds:us-east-1:111111111111:db:dev-db", "available",
+					map[string]string{"Environment": "dev", "Team": "shop"}),
+				dbInstance("prod-db", "arn:aws:rds:us-east-1:111111111111:db:prod-db", "available",
+					map[string]string{"Environment": "prod", "Team": "shop"}),
+			},
+			{
+				dbInstance("dev-reporting", "arn:aws-cn:rds:cn-north-1:222222222222:db:dev-reporting", "stopped",
+					map[string]string{"Environment": "dev", "Team": "shop"}),
+				dbInstance("untagged", "arn:aws:rds:eu-west-1:333333333333:db:untagged", "available", nil),
+			},
+		},
+	}
+	manager := NewRDSManager(client)
+
+	ids, err := manager.GetByTags(context.Background(), []models.ResourceTag{
+		{Key: "Environment", Value: "dev"},
+		{Key: "Team", Value: "shop"},
+	})
+	if err != nil {
+		t.Fatalf("GetByTags returned error: %v", err)
+	}
+
+	want := []string{"dev-db", "dev-reporting"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetByTags = %v, want %v", ids, want)
+	}
+	if client.calls != 2 {
+		t.Errorf("DescribeDBInstances called %d times, want 2 (one per page)", client.calls)
+	}
+}
+
+func TestRDSGetByTagsSkipsClusterMembers(t *testing.T) {
+	member := dbInstance("aurora-1", "arn:aws:rds:us-east-1:111111111111:db:aurora-1", "available",
+		map[string]string{"Environment": "dev"})
+	member.DBClusterIdentifier = aws.String("aurora")
+	client := &fakeRDSClient{
+		pages: [][]types.DBInstance{
+			{
+				member,
+				dbInstance("dev-db", "arn:aws:rds:us-east-1:111111111111:db:dev-db", "available",
+					map[string]string{"Environment": "dev"}),
+			},
+		},
+	}
+
+	ids, err := NewRDSManager(client).GetByTags(context.Background(), []models.ResourceTag{{Key: "Environment", Value: "dev"}})
+	if err != nil {
+		t.Fatalf("GetByTags returned error: %v", err)
+	}
+	if want := []string{"dev-db"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetByTags = %v, want %v", ids, want)
+	}
+}
+
+func TestRDSGetByTagsNoMatch(t *testing.T) {
+	client := &fakeRDSClient{
+		pages: [][]types.DBInstance{
+			{dbInstance("prod-db", "arn:aws:rds:ap-northeast-2:444444444444:db:prod-db", "available",
+				map[string]string{"Environment": "prod"})},
+		},
+	}
+
+	ids, err := NewRDSManager(client).GetByTags(context.Background(), []models.ResourceTag{{Key: "Environment", Value: "dev"}})
+	if err != nil {
+		t.Fatalf("GetByTags returned error: %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("GetByTags = %v, want no matches", ids)
+	}
+}
+
+func TestRDSGetByTagsDescribeError(t *testing.T) {
+	client := &fakeRDSClient{err: errors.New("access denied")}
+
+	if _, err := NewRDSManager(client).GetByTags(context.Background(), nil); err == nil {
+		t.Fatal("GetByTags returned nil error, want describe error")
+	}
+}
+
+func TestRDSStartStopUseInstanceIdentifiers(t *testing.T) {
+	client := &fakeRDSClient{}
+	manager := NewRDSManager(client)
+
+	if err := manager.Start(context.Background(), []string{"a", "b"}); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	if err := manager.Stop(context.Background(), []string{"c"}); err != nil {
+		t.Fatalf("Stop returned error: %v", err)
+	}
+
+	if want := []string{"a", "b"}; !reflect.DeepEqual(client.started, want) {
+		t.Errorf("started = %v, want %v", client.started, want)
+	}
+	if want := []string{"c"}; !reflect.DeepEqual(client.stopped, want) {
+		t.Errorf("stopped = %v, want %v", client.stopped, want)
+	}
+}
+
+func TestRDSGetStates(t *testing.T) {
+	client := &fakeRDSClient{
+		pages: [][]types.DBInstance{
+			{
+				dbInstance("a", "arn:aws:rds:us-west-2:555555555555:db:a", "available", nil),
+				dbInstance("b", "arn:aws:rds:us-west-2:555555555555:db:b", "stopping", nil),
+				dbInstance("c", "arn:aws:rds:us-west-2:555555555555:db:c", "stopped", nil),
+			},
+		},
+	}
+
+	states, err := NewRDSManager(client).GetStates(context.Background(), []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("GetStates returned error: %v", err)
+	}
+
+	want := map[string]string{"a": StateRunning, "b": StatePending, "c": StateStopped}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("GetStates = %v, want %v", states, want)
+	}
+}
+
+// fakeRDSClusterClient는 DescribeDBClusters 응답을 돌려주는 가짜 RDS 클라이언트입니다.
+type fakeRDSClusterClient struct {
+	clusters []types.DBCluster
+	started  []string
+	stopped  []string
+}
+
+func (f *fakeRDSClusterClient) DescribeDBClusters(_ context.Context, params *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return &rds.DescribeDBClustersOutput{DBClusters: f.clusters}, nil
+}
+
+func (f *fakeRDSClusterClient) StartDBCluster(_ context.Context, params *rds.StartDBClusterInput, _ ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	f.started = append(f.started, aws.ToString(params.DBClusterIdentifier))
+	return &rds.StartDBClusterOutput{}, nil
+}
+
+func (f *fakeRDSClusterClient) StopDBCluster(_ context.Context, params *rds.StopDBClusterInput, _ ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	f.stopped = append(f.stopped, aws.ToString(params.DBClusterIdentifier))
+	return &rds.StopDBClusterOutput{}, nil
+}
+
+func TestRDSClusterGetByTagsAndStop(t *testing.T) {
+	client := &fakeRDSClusterClient{
+		clusters: []types.DBCluster{
+			{
+				DBClusterIdentifier: aws.String("aurora-dev"),
+				Status:              aws.String("available"),
+				TagList:             []types.Tag{{Key: aws.String("Environment"), Value: aws.String("dev")}},
+			},
+			{
+				DBClusterIdentifier: aws.String("aurora-prod"),
+				Status:              aws.String("available"),
+				TagList:             []types.Tag{{Key: aws.String("Environment"), Value: aws.String("prod")}},
+			},
+		},
+	}
+	manager := NewRDSClusterManager(client)
+
+	ids, err := manager.GetByTags(context.Background(), []models.ResourceTag{{Key: "Environment", Value: "dev"}})
+	if err != nil {
+		t.Fatalf("GetByTags returned error: %v", err)
+	}
+	if want := []string{"aurora-dev"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("GetByTags = %v, want %v", ids, want)
+	}
+
+	if err := manager.Stop(context.Background(), ids); err != nil {
+		t.Fatalf("Stop returned error: %v", err)
+	}
+	if !reflect.DeepEqual(client.stopped, ids) {
+		t.Errorf("stopped = %v, want %v", client.stopped, ids)
+	}
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ResourceType은 리소스 그룹에서 사용할 수 있는 리소스 유형과 매니저 생성 함수입니다.
+// 각 매니저 파일의 init에서 RegisterResourceType으로 등록하며, 스케줄러와 API 검증이 같은 목록을 사용합니다.
+type ResourceType struct {
+	Name        string   `json:"name"`         // 리소스 항목의 type 값 (예: EC2)
+	DisplayName string   `json:"display_name"` // 화면에 표시할 이름
+	Actions     []string `json:"actions"`      // 지원하는 작업 (start, stop)
+	StateQuery  bool     `json:"state_query"`  // 상태 조회 지원 여부 (목표 상태 대기, 드리프트 감지에 사용)
+
+	// NewManager는 클라이언트의 서비스 클라이언트와 용량 저장소로 매니저를 생성합니다.
+	NewManager func(apis ClientAPIs, store CapacityStore) AWSResourceManager `json:"-"`
+}
+
+// SupportsAction은 리소스 유형이 작업을 지원하는지 확인합니다.
+func (t ResourceType) SupportsAction(action string) bool {
+	return slices.Contains(t.Actions, action)
+}
+
+var (
+	resourceTypesMu sync.RWMutex
+	resourceTypes   = make(map[string]ResourceType)
+)
+
+// startStop은 시작과 중지를 모두 지원하는 리소스 유형의 작업 목록입니다.
+var startStop = []string{models.ActionStart, models.ActionStop}
+
+// RegisterResourceType은 리소스 유형을 등록합니다. 이름이 비어 있거나 이미 등록된 이름이면 panic합니다.
+// 등록 이후 생성되는 AWSClient부터 매니저가 만들어지므로 init에서 호출해야 합니다.
+func RegisterResourceType(resourceType ResourceType) {
+	resourceTypesMu.Lock()
+	defer resourceTypesMu.Unlock()
+
+	if resourceType.Name == "" || resourceType.NewManager == nil {
+		panic("aws: RegisterResourceType requires a name and NewManager")
+	}
+	if _, exists := resourceTypes[resourceType.Name]; exists {
+		panic("aws: RegisterResourceType called twice for " + resourceType.Name)
+	}
+	resourceTypes[resourceType.Name] = resourceType
+}
+
+// LookupResourceType은 이름으로 등록된 리소스 유형을 찾습니다.
+func LookupResourceType(name string) (ResourceType, bool) {
+	resourceTypesMu.RLock()
+	defer resourceTypesMu.RUnlock()
+	resourceType, exists := resourceTypes[name]
+	return resourceType, exists
+}
+
+// ResourceTypes는 등록된 모든 리소스 유형을 이름순으로 반환합니다.
+func ResourceTypes() []ResourceType {
+	resourceTypesMu.RLock()
+	defer resourceTypesMu.RUnlock()
+
+	types := make([]ResourceType, 0, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		types = append(types, resourceType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types
+}
+
+// ValidateResourceType은 리소스 유형이 등록되어 있는지 확인합니다.
+func ValidateResourceType(name string) error {
+	if _, exists := LookupResourceType(name); !exists {
+		return fmt.Errorf("unsupported resource type %q", name)
+	}
+	return nil
+}
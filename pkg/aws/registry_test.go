@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func TestResourceTypes(t *testing.T) {
+	want := []string{"ASG", "EC2", "ECS", "ECS_SERVICE", "RDS", "RDS_CLUSTER"}
+	registered := ResourceTypes()
+	if len(registered) != len(want) {
+		t.Fatalf("got %d resource types, want %d", len(registered), len(want))
+	}
+
+	for i, resourceType := range registered {
+		if resourceType.Name != want[i] {
+			t.Errorf("resource type %d = %s, want %s", i, resourceType.Name, want[i])
+		}
+		if resourceType.DisplayName == "" {
+			t.Errorf("%s has no display name", resourceType.Name)
+		}
+		if !resourceType.SupportsAction(models.ActionStart) || !resourceType.SupportsAction(models.ActionStop) {
+			t.Errorf("%s actions = %v, want start and stop", resourceType.Name, resourceType.Actions)
+		}
+
+		// 메타데이터의 상태 조회 지원 여부가 매니저 구현과 일치해야 함
+		manager := resourceType.NewManager(ClientAPIs{}, nil)
+		if _, ok := manager.(ResourceStateReader); ok != resourceType.StateQuery {
+			t.Errorf("%s state_query = %v, but manager implements ResourceStateReader: %v", resourceType.Name, resourceType.StateQuery, ok)
+		}
+	}
+}
+
+func TestValidateResourceType(t *testing.T) {
+	if err := ValidateResourceType("RDS_CLUSTER"); err != nil {
+		t.Errorf("ValidateResourceType(RDS_CLUSTER) returned error: %v", err)
+	}
+	for _, name := range []string{"", "S3", "ec2"} {
+		if err := ValidateResourceType(name); err == nil {
+			t.Errorf("ValidateResourceType(%q) returned nil, want error", name)
+		}
+	}
+}
@@ -2,9 +2,11 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 )
 
@@ -14,14 +16,75 @@ type AWSResourceManager interface {
 	GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error)
 }
 
-// 공통 태그 필터링 함수
-func BuildTagFilters(resourceTags []models.ResourceTag) []types.Filter {
-	var filters []types.Filter
-	for _, tag := range resourceTags {
-		filters = append(filters, types.Filter{
-			Name:   aws.String("tag:" + tag.Key), // "tag:Key" 형식의 필터 이름
-			Values: []string{tag.Value},          // 필터 값 리스트
-		})
+// 리소스 상태 (매니저별 상태 값을 공통 값으로 정규화)
+const (
+	StateRunning = "running" // 실행 중 (EC2 running, RDS available, ECS runningCount == desiredCount > 0)
+	StateStopped = "stopped" // 중지됨
+	StatePending = "pending" // 시작 또는 중지 전환 중
+	StateUnknown = "unknown" // 그 외 상태 (예: RDS failed)
+)
+
+// ResourceStateReader는 리소스의 현재 상태를 조회할 수 있는 매니저가 구현하는 선택적 인터페이스입니다.
+// 구현한 매니저는 Start/Stop 이후 리소스가 목표 상태에 도달할 때까지 기다리는 단계를 지원합니다.
+// 반환 맵에 없는 리소스 (예: 종료된 인스턴스) 는 기다릴 대상에서 제외됩니다.
+type ResourceStateReader interface {
+	GetStates(ctx context.Context, resourceIDs []string) (map[string]string, error)
+}
+
+// DefaultDesiredCountSetter는 저장된 용량이 없는 리소스를 시작할 때 사용할 DesiredCount를 그룹별로 설정할 수 있는 매니저가 구현합니다 (ECS).
+// 매니저는 클라이언트에서 여러 그룹이 함께 사용하므로 설정한 복사본을 반환합니다.
+type DefaultDesiredCountSetter interface {
+	WithDefaultDesiredCount(count int32) AWSResourceManager
+}
+
+// ExplicitIDFilter는 명시적으로 지정한 리소스 ID 중 GetByTags가 제외하는 리소스를 같은 기준으로 걸러내는 매니저가 구현합니다
+// (예: Auto Scaling 그룹에 속한 EC2 인스턴스).
+type ExplicitIDFilter interface {
+	FilterIDs(ctx context.Context, resourceIDs []string) ([]string, error)
+}
+
+// WaitForState는 모든 리소스가 목표 상태에 도달할 때까지 interval 간격으로 상태를 조회합니다.
+// ctx가 만료되면 아직 목표 상태가 아닌 리소스 목록과 함께 에러를 반환합니다.
+func WaitForState(ctx context.Context, reader ResourceStateReader, resourceIDs []string, target string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var notReady map[string]string
+	for {
+		states, err := reader.GetStates(ctx, resourceIDs)
+		if err != nil {
+			// 일시적인 API 오류는 다음 조회에서 다시 시도
+			log.Printf("failed to get resource states while waiting for %s: %v", target, err)
+		} else {
+			notReady = make(map[string]string)
+			for _, resourceID := range resourceIDs {
+				if state, exists := states[resourceID]; exists && state != target {
+					notReady[resourceID] = state
+				}
+			}
+			if len(notReady) == 0 {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for resources to become %s: %s", target, formatStates(notReady))
+		case <-ticker.C:
+		}
+	}
+}
+
+// formatStates는 리소스별 상태를 "id=state" 형식의 정렬된 문자열로 변환합니다.
+func formatStates(states map[string]string) string {
+	if len(states) == 0 {
+		return "state unavailable"
+	}
+
+	pairs := make([]string, 0, len(states))
+	for resourceID, state := range states {
+		pairs = append(pairs, resourceID+"="+state)
 	}
-	return filters
+	sort.Strings(pairs)
+	return fmt.Sprint(pairs)
 }
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ValidateTagSelectors는 태그 선택자의 연산자와 값이 올바른지 확인합니다.
+func ValidateTagSelectors(selectors []models.ResourceTag) error {
+	for _, selector := range selectors {
+		if selector.Key == "" {
+			return fmt.Errorf("tag key is required")
+		}
+		switch selector.Operator {
+		case "", models.TagOpEquals, models.TagOpNotEquals, models.TagOpPrefix:
+		case models.TagOpExists, models.TagOpNotExists:
+			if selector.Value != "" || len(selector.Values) > 0 {
+				return fmt.Errorf("tag %q: operator %s does not take a value", selector.Key, selector.Operator)
+			}
+		case models.TagOpIn:
+			if len(selector.Values) == 0 {
+				return fmt.Errorf("tag %q: operator in requires values", selector.Key)
+			}
+		case models.TagOpGlob:
+			if selector.Value == "" {
+				return fmt.Errorf("tag %q: operator glob requires a value", selector.Key)
+			}
+		default:
+			return fmt.Errorf("tag %q: unknown operator %q", selector.Key, selector.Operator)
+		}
+	}
+	return nil
+}
+
+// MatchTags는 리소스의 태그 (키-값 맵) 가 모든 태그 선택자를 만족하는지 확인합니다.
+// 여러 리소스를 확인할 때는 NewTagMatcher로 선택자를 한 번만 컴파일하여 사용합니다.
+func MatchTags(selectors []models.ResourceTag, tags map[string]string) bool {
+	return NewTagMatcher(selectors).Match(tags)
+}
+
+// TagMatcher는 glob 패턴을 미리 정규식으로 변환한 태그 선택자 목록입니다.
+type TagMatcher struct {
+	selectors []models.ResourceTag
+	globs     []*regexp.Regexp // selectors와 같은 순서 (glob 선택자가 아니면 nil)
+}
+
+// NewTagMatcher는 태그 선택자의 glob 패턴을 한 번 컴파일하여 TagMatcher를 만듭니다.
+func NewTagMatcher(selectors []models.ResourceTag) TagMatcher {
+	globs := make([]*regexp.Regexp, len(selectors))
+	for i, selector := range selectors {
+		if selector.Operator == models.TagOpGlob {
+			globs[i] = globRegexp(selector.Value)
+		}
+	}
+	return TagMatcher{selectors: selectors, globs: globs}
+}
+
+// Match는 리소스의 태그가 모든 태그 선택자를 만족하는지 확인합니다.
+func (m TagMatcher) Match(tags map[string]string) bool {
+	for i, selector := range m.selectors {
+		if !matchTag(selector, m.globs[i], tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTag는 하나의 태그 선택자를 평가합니다. glob은 glob 선택자의 컴파일된 패턴입니다.
+func matchTag(selector models.ResourceTag, glob *regexp.Regexp, tags map[string]string) bool {
+	value, exists := tags[selector.Key]
+	switch selector.Operator {
+	case "", models.TagOpEquals:
+		return exists && value == selector.Value
+	case models.TagOpNotEquals:
+		return !exists || value != selector.Value
+	case models.TagOpExists:
+		return exists
+	case models.TagOpNotExists:
+		return !exists
+	case models.TagOpIn:
+		return exists && slices.Contains(selector.Values, value)
+	case models.TagOpPrefix:
+		return exists && strings.HasPrefix(value, selector.Value)
+	case models.TagOpGlob:
+		return exists && glob.MatchString(value)
+	default:
+		return false
+	}
+}
+
+// globRegexp는 * (0개 이상의 문자) 와 ? (문자 하나) 와일드카드 패턴을 정규식으로 변환합니다.
+// EC2 필터 값과 같이 백슬래시는 다음 문자를 그대로 비교하도록 이스케이프합니다 (예: \* 는 문자 *, \\ 는 문자 \).
+// 마지막 문자인 백슬래시는 문자 그대로 비교합니다.
+func globRegexp(pattern string) *regexp.Regexp {
+	var builder strings.Builder
+	builder.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			builder.WriteString(".*")
+		case r == '?':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		builder.WriteString(regexp.QuoteMeta(`\`))
+	}
+	builder.WriteString("$")
+	return regexp.MustCompile(builder.String())
+}
+
+// tagMap은 AWS 서비스별 태그 목록을 키-값 맵으로 변환합니다.
+func tagMap[T any](awsTags []T, keyValue func(T) (*string, *string)) map[string]string {
+	tags := make(map[string]string, len(awsTags))
+	for _, tag := range awsTags {
+		key, value := keyValue(tag)
+		tags[aws.ToString(key)] = aws.ToString(value)
+	}
+	return tags
+}
+
+// BuildTagFilters는 EC2 DescribeInstances에서 서버 측으로 처리할 수 있는 선택자 (equals, in, exists, prefix, glob) 를 필터로 변환합니다.
+// not_equals와 not_exists는 EC2 필터로 표현할 수 없으므로 MatchTags로 클라이언트에서 확인해야 합니다.
+func BuildTagFilters(resourceTags []models.ResourceTag) []types.Filter {
+	var filters []types.Filter
+	for _, tag := range resourceTags {
+		switch tag.Operator {
+		case "", models.TagOpEquals:
+			filters = append(filters, tagFilter("tag:"+tag.Key, escapeFilterValue(tag.Value)))
+		case models.TagOpIn:
+			values := make([]string, 0, len(tag.Values))
+			for _, value := range tag.Values {
+				values = append(values, escapeFilterValue(value))
+			}
+			filters = append(filters, tagFilter("tag:"+tag.Key, values...))
+		case models.TagOpExists:
+			filters = append(filters, tagFilter("tag-key", escapeFilterValue(tag.Key)))
+		case models.TagOpPrefix:
+			filters = append(filters, tagFilter("tag:"+tag.Key, escapeFilterValue(tag.Value)+"*"))
+		case models.TagOpGlob:
+			// EC2 필터 값도 * 와 ? 와일드카드와 백슬래시 이스케이프를 globRegexp와 같은 의미로 지원하므로 패턴을 그대로 사용
+			filters = append(filters, tagFilter("tag:"+tag.Key, tag.Value))
+		}
+	}
+	return filters
+}
+
+func tagFilter(name string, values ...string) types.Filter {
+	return types.Filter{
+		Name:   aws.String(name), // "tag:Key" 형식의 필터 이름
+		Values: values,           // 필터 값 리스트
+	}
+}
+
+// escapeFilterValue는 EC2 필터 값에서 와일드카드로 해석되는 문자를 이스케이프합니다.
+func escapeFilterValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`).Replace(value)
+}
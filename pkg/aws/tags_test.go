@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func TestMatchTags(t *testing.T) {
+	tags := map[string]string{"env": "dev-eu", "team": "web"}
+
+	tests := []struct {
+		name     string
+		selector models.ResourceTag
+		want     bool
+	}{
+		{"equals", models.ResourceTag{Key: "team", Value: "web"}, true},
+		{"equals mismatch", models.ResourceTag{Key: "team", Value: "api"}, false},
+		{"not_equals", models.ResourceTag{Key: "env", Operator: models.TagOpNotEquals, Value: "prod"}, true},
+		{"not_equals missing key", models.ResourceTag{Key: "owner", Operator: models.TagOpNotEquals, Value: "x"}, true},
+		{"exists", models.ResourceTag{Key: "env", Operator: models.TagOpExists}, true},
+		{"not_exists", models.ResourceTag{Key: "env", Operator: models.TagOpNotExists}, false},
+		{"in", models.ResourceTag{Key: "team", Operator: models.TagOpIn, Values: []string{"api", "web"}}, true},
+		{"in mismatch", models.ResourceTag{Key: "team", Operator: models.TagOpIn, Values: []string{"api"}}, false},
+		{"prefix", models.ResourceTag{Key: "env", Operator: models.TagOpPrefix, Value: "dev-"}, true},
+		{"glob", models.ResourceTag{Key: "env", Operator: models.TagOpGlob, Value: "dev-??"}, true},
+		{"glob literal dot", models.ResourceTag{Key: "env", Operator: models.TagOpGlob, Value: "dev.eu"}, false},
+		{"glob escaped wildcard", models.ResourceTag{Key: "team", Operator: models.TagOpGlob, Value: `w\*`}, false},
+		{"glob escaped letter", models.ResourceTag{Key: "team", Operator: models.TagOpGlob, Value: `\web`}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchTags([]models.ResourceTag{tt.selector}, tags); got != tt.want {
+				t.Errorf("MatchTags(%+v) = %v, want %v", tt.selector, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTagsRequiresAllSelectors(t *testing.T) {
+	tags := map[string]string{"env": "staging"}
+	selectors := []models.ResourceTag{
+		{Key: "env", Operator: models.TagOpExists},
+		{Key: "env", Operator: models.TagOpNotEquals, Value: "prod"},
+	}
+	if !MatchTags(selectors, tags) {
+		t.Error("env=staging should match \"env exists and env != prod\"")
+	}
+	if MatchTags(selectors, map[string]string{"env": "prod"}) {
+		t.Error("env=prod should not match \"env exists and env != prod\"")
+	}
+}
+
+// glob 패턴의 백슬래시는 EC2 필터 값과 같이 다음 문자를 이스케이프함
+func TestGlobRegexpEscapes(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{`a\*b`, "a*b", true},
+		{`a\*b`, "axb", false},
+		{`a\?b`, "a?b", true},
+		{`a\?b`, "axb", false},
+		{`a\\*`, `a\bc`, true},
+		{`a\\*`, "abc", false},
+		{`a\`, `a\`, true},
+	}
+	for _, tt := range tests {
+		if got := globRegexp(tt.pattern).MatchString(tt.value); got != tt.want {
+			t.Errorf("glob %q matching %q = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
+
+// EC2에는 glob 패턴을 그대로 전달하여 globRegexp와 같은 이스케이프 의미를 유지함
+func TestBuildTagFiltersPassesGlobUnchanged(t *testing.T) {
+	filters := BuildTagFilters([]models.ResourceTag{{Key: "env", Operator: models.TagOpGlob, Value: `dev-\*`}})
+	if len(filters) != 1 || len(filters[0].Values) != 1 || filters[0].Values[0] != `dev-\*` {
+		t.Errorf("BuildTagFilters = %+v, want one filter with value dev-\\*", filters)
+	}
+}
+
+func TestValidateTagSelectors(t *testing.T) {
+	invalid := [][]models.ResourceTag{
+		{{Key: ""}},
+		{{Key: "env", Operator: "regex", Value: ".*"}},
+		{{Key: "env", Operator: models.TagOpIn}},
+		{{Key: "env", Operator: models.TagOpExists, Value: "dev"}},
+		{{Key: "env", Operator: models.TagOpGlob}},
+	}
+	for _, selectors := range invalid {
+		if err := ValidateTagSelectors(selectors); err == nil {
+			t.Errorf("ValidateTagSelectors(%+v) returned nil, want error", selectors)
+		}
+	}
+
+	if err := ValidateTagSelectors([]models.ResourceTag{{Key: "env", Value: "dev"}}); err != nil {
+		t.Errorf("ValidateTagSelectors returned error for a plain key/value tag: %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// icsDateLayout은 iCalendar의 DATE 값 형식입니다 (예: 20250101).
+const icsDateLayout = "20060102"
+
+// maxEventDays는 여러 날에 걸친 이벤트를 휴일로 펼칠 때 허용하는 최대 일수입니다.
+const maxEventDays = 366
+
+// ParseICS는 iCalendar (RFC 5545) 데이터의 VEVENT에서 휴일 날짜를 추출합니다.
+// DTSTART의 날짜를 휴일로 사용하며, 종일 이벤트의 DTEND가 있으면 종료일 전날까지 펼칩니다.
+// 반복 규칙 (RRULE, RDATE) 은 지원하지 않으며, 반복 이벤트가 있으면 휴일이 조용히 빠지지 않도록 에러를 반환합니다.
+// 반복 휴일은 날짜별 이벤트로 내보내야 합니다.
+func ParseICS(r io.Reader) ([]models.Holiday, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		holidays []models.Holiday
+		inEvent  bool
+		start    string
+		end      string
+		summary  string
+		rule     string
+	)
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		// 속성 파라미터 제거 (예: DTSTART;VALUE=DATE)
+		name, _, _ = strings.Cut(strings.ToUpper(name), ";")
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, start, end, summary, rule = true, "", "", "", ""
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if !inEvent {
+				continue
+			}
+			inEvent = false
+			if rule != "" {
+				return nil, fmt.Errorf("VEVENT %q has a recurrence rule (%s), which is not supported; export recurring holidays as one event per date", summary, rule)
+			}
+			eventHolidays, err := expandEvent(start, end, summary)
+			if err != nil {
+				return nil, err
+			}
+			holidays = append(holidays, eventHolidays...)
+		case inEvent && name == "DTSTART":
+			start = value
+		case inEvent && name == "DTEND":
+			end = value
+		case inEvent && (name == "RRULE" || name == "RDATE"):
+			rule = name
+		case inEvent && name == "SUMMARY":
+			summary = unescapeText(value)
+		}
+	}
+
+	return NormalizeHolidays(holidays)
+}
+
+// NormalizeHolidays는 휴일 날짜 형식 (YYYY-MM-DD) 을 검증하고 날짜순으로 정렬하며 중복을 제거합니다.
+func NormalizeHolidays(holidays []models.Holiday) ([]models.Holiday, error) {
+	seen := make(map[string]bool)
+	normalized := make([]models.Holiday, 0, len(holidays))
+	for _, holiday := range holidays {
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(holiday.Date))
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: expected YYYY-MM-DD", holiday.Date)
+		}
+
+		key := date.Format(time.DateOnly)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, models.Holiday{Date: key, Name: strings.TrimSpace(holiday.Name)})
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Date < normalized[j].Date
+	})
+	return normalized, nil
+}
+
+// expandEvent는 하나의 VEVENT를 휴일 목록으로 변환합니다.
+func expandEvent(start, end, summary string) ([]models.Holiday, error) {
+	if start == "" {
+		return nil, fmt.Errorf("VEVENT %q has no DTSTART", summary)
+	}
+
+	startDate, err := parseICSDate(start)
+	if err != nil {
+		return nil, err
+	}
+
+	days := 1
+	// 종일 이벤트의 DTEND는 종료일 다음 날을 가리킴 (exclusive)
+	if end != "" && len(end) == len(icsDateLayout) {
+		endDate, err := parseICSDate(end)
+		if err != nil {
+			return nil, err
+		}
+		if span := int(endDate.Sub(startDate).Hours() / 24); span > 1 {
+			days = span
+		}
+	}
+	if days > maxEventDays {
+		return nil, fmt.Errorf("VEVENT %q spans %d days, more than %d", summary, days, maxEventDays)
+	}
+
+	holidays := make([]models.Holiday, 0, days)
+	for i := 0; i < days; i++ {
+		holidays = append(holidays, models.Holiday{
+			Date: startDate.AddDate(0, 0, i).Format(time.DateOnly),
+			Name: summary,
+		})
+	}
+	return holidays, nil
+}
+
+// parseICSDate는 DATE (20250101) 또는 DATE-TIME (20250101T000000Z) 값에서 날짜 부분을 읽습니다.
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(icsDateLayout) {
+		return time.Time{}, fmt.Errorf("invalid iCalendar date %q", value)
+	}
+	date, err := time.Parse(icsDateLayout, value[:len(icsDateLayout)])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid iCalendar date %q", value)
+	}
+	return date, nil
+}
+
+// unfoldLines는 공백으로 시작하는 이어진 줄을 앞 줄에 합쳐 논리적인 줄 목록을 반환합니다.
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read iCalendar data: %w", err)
+	}
+	return lines, nil
+}
+
+// unescapeText는 iCalendar TEXT 값의 이스케이프 문자를 복원합니다.
+func unescapeText(value string) string {
+	replacer := strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return strings.TrimSpace(replacer.Replace(value))
+}
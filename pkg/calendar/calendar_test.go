@@ -0,0 +1,167 @@
+package calendar
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ics는 VEVENT 본문 목록을 VCALENDAR로 감쌉니다. 줄 끝은 RFC 5545와 같이 CRLF입니다.
+func ics(events ...string) string {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}
+	for _, event := range events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, strings.Split(event, "\n")...)
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestParseICS(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []models.Holiday
+	}{
+		{
+			name:  "all-day event",
+			input: ics("DTSTART;VALUE=DATE:20250101\nDTEND;VALUE=DATE:20250102\nSUMMARY:New Year"),
+			want:  []models.Holiday{{Date: "2025-01-01", Name: "New Year"}},
+		},
+		{
+			name:  "multi-day event with exclusive DTEND",
+			input: ics("DTSTART;VALUE=DATE:20250128\nDTEND;VALUE=DATE:20250131\nSUMMARY:Seollal"),
+			want: []models.Holiday{
+				{Date: "2025-01-28", Name: "Seollal"},
+				{Date: "2025-01-29", Name: "Seollal"},
+				{Date: "2025-01-30", Name: "Seollal"},
+			},
+		},
+		{
+			name:  "event without DTEND",
+			input: ics("DTSTART;VALUE=DATE:20250301\nSUMMARY:Independence Movement Day"),
+			want:  []models.Holiday{{Date: "2025-03-01", Name: "Independence Movement Day"}},
+		},
+		{
+			name:  "DATE-TIME values use the date part and are not expanded",
+			input: ics("DTSTART:20250505T000000Z\nDTEND:20250507T000000Z\nSUMMARY:Children's Day"),
+			want:  []models.Holiday{{Date: "2025-05-05", Name: "Children's Day"}},
+		},
+		{
+			name:  "DATE-TIME with TZID",
+			input: ics("DTSTART;TZID=Asia/Seoul:20250606T090000\nSUMMARY:Memorial Day"),
+			want:  []models.Holiday{{Date: "2025-06-06", Name: "Memorial Day"}},
+		},
+		{
+			name:  "folded lines and escaped text",
+			input: ics("DTSTART;VALUE=DATE:20251003\nSUMMARY:National Foundation\n  Day\\, Korea"),
+			want:  []models.Holiday{{Date: "2025-10-03", Name: "National Foundation Day, Korea"}},
+		},
+		{
+			name: "events are sorted and deduplicated",
+			input: ics(
+				"DTSTART;VALUE=DATE:20251225\nSUMMARY:Christmas",
+				"DTSTART;VALUE=DATE:20250815\nSUMMARY:Liberation Day",
+				"DTSTART;VALUE=DATE:20251225\nSUMMARY:Christmas (copy)",
+			),
+			want: []models.Holiday{
+				{Date: "2025-08-15", Name: "Liberation Day"},
+				{Date: "2025-12-25", Name: "Christmas"},
+			},
+		},
+		{
+			name:  "LF line endings",
+			input: strings.ReplaceAll(ics("DTSTART;VALUE=DATE:20250101\nSUMMARY:New Year"), "\r\n", "\n"),
+			want:  []models.Holiday{{Date: "2025-01-01", Name: "New Year"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseICS(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ParseICS returned error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseICS = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseICSErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "recurring event",
+			input:   ics("DTSTART;VALUE=DATE:20250101\nRRULE:FREQ=YEARLY\nSUMMARY:New Year"),
+			wantErr: "recurrence rule (RRULE)",
+		},
+		{
+			name:    "recurrence dates",
+			input:   ics("DTSTART;VALUE=DATE:20250101\nRDATE;VALUE=DATE:20260101\nSUMMARY:New Year"),
+			wantErr: "recurrence rule (RDATE)",
+		},
+		{
+			name:    "event longer than 366 days",
+			input:   ics("DTSTART;VALUE=DATE:20250101\nDTEND;VALUE=DATE:20260103\nSUMMARY:Sabbatical"),
+			wantErr: "spans 367 days",
+		},
+		{
+			name:    "missing DTSTART",
+			input:   ics("SUMMARY:Unknown"),
+			wantErr: "has no DTSTART",
+		},
+		{
+			name:    "invalid date",
+			input:   ics("DTSTART;VALUE=DATE:2025-01-01\nSUMMARY:New Year"),
+			wantErr: "invalid iCalendar date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseICS(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseICS error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseICSAllowsMaxEventDays(t *testing.T) {
+	got, err := ParseICS(strings.NewReader(ics("DTSTART;VALUE=DATE:20250101\nDTEND;VALUE=DATE:20260102\nSUMMARY:Long")))
+	if err != nil {
+		t.Fatalf("ParseICS returned error: %v", err)
+	}
+	if len(got) != maxEventDays {
+		t.Errorf("got %d holidays, want %d", len(got), maxEventDays)
+	}
+}
+
+func TestNormalizeHolidays(t *testing.T) {
+	got, err := NormalizeHolidays([]models.Holiday{
+		{Date: "2025-12-25", Name: " Christmas "},
+		{Date: " 2025-01-01"},
+		{Date: "2025-12-25", Name: "duplicate"},
+	})
+	if err != nil {
+		t.Fatalf("NormalizeHolidays returned error: %v", err)
+	}
+	want := []models.Holiday{{Date: "2025-01-01"}, {Date: "2025-12-25", Name: "Christmas"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("NormalizeHolidays = %v, want %v", got, want)
+	}
+
+	for _, date := range []string{"2025/01/01", "20250101", "2025-02-30"} {
+		if _, err := NormalizeHolidays([]models.Holiday{{Date: date}}); err == nil {
+			t.Errorf("NormalizeHolidays(%q) returned no error", date)
+		}
+	}
+}
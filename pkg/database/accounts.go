@@ -0,0 +1,112 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+var (
+	// ErrAccountNotFound는 요청한 AWS 계정이 존재하지 않을 때 반환됩니다.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrAccountInUse는 리소스 그룹이 사용 중인 AWS 계정을 삭제하려 할 때 반환됩니다.
+	ErrAccountInUse = errors.New("account is used by a resource group")
+)
+
+// accountColumns는 scanAccount가 기대하는 aws_accounts 테이블의 컬럼 순서입니다.
+const accountColumns = "id, name, aws_account_id, role_arn, external_id, regions, created_at"
+
+// AddAccount는 AWS 계정을 저장하고 생성된 ID와 생성 시각을 account에 채웁니다.
+func (db *DB) AddAccount(account *models.AWSAccount) error {
+	query := `
+		INSERT INTO aws_accounts (name, aws_account_id, role_arn, external_id, regions)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err := db.Conn.QueryRow(query, account.Name, account.AccountID, account.RoleARN, account.ExternalID,
+		pq.Array(nonNilStrings(account.Regions))).Scan(&account.ID, &account.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add account: %v", err)
+	}
+	return nil
+}
+
+// GetAccounts는 모든 AWS 계정을 반환합니다.
+func (db *DB) GetAccounts() ([]models.AWSAccount, error) {
+	rows, err := db.Conn.Query("SELECT " + accountColumns + " FROM aws_accounts ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accounts: %v", err)
+	}
+	defer rows.Close()
+
+	accounts := []models.AWSAccount{}
+	for rows.Next() {
+		account, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account: %v", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return accounts, nil
+}
+
+// GetAccount는 특정 AWS 계정을 반환합니다.
+func (db *DB) GetAccount(accountID int) (models.AWSAccount, error) {
+	row := db.Conn.QueryRow("SELECT "+accountColumns+" FROM aws_accounts WHERE id = $1", accountID)
+	account, err := scanAccount(row)
+	if err == sql.ErrNoRows {
+		return models.AWSAccount{}, ErrAccountNotFound
+	}
+	if err != nil {
+		return models.AWSAccount{}, fmt.Errorf("failed to scan account: %v", err)
+	}
+	return account, nil
+}
+
+// AccountExists는 특정 AWS 계정이 데이터베이스에 존재하는지 확인합니다.
+func (db *DB) AccountExists(accountID int) (bool, error) {
+	var exists bool
+	err := db.Conn.QueryRow("SELECT EXISTS(SELECT 1 FROM aws_accounts WHERE id = $1)", accountID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if account exists: %v", err)
+	}
+	return exists, nil
+}
+
+// DeleteAccount는 AWS 계정을 삭제합니다. 리소스 그룹이 사용 중인 계정은 삭제하지 않고 ErrAccountInUse를 반환합니다.
+func (db *DB) DeleteAccount(accountID int) error {
+	var inUse bool
+	err := db.Conn.QueryRow("SELECT EXISTS(SELECT 1 FROM resource_groups WHERE account_id = $1)", accountID).Scan(&inUse)
+	if err != nil {
+		return fmt.Errorf("failed to check account usage: %v", err)
+	}
+	if inUse {
+		return ErrAccountInUse
+	}
+
+	result, err := db.Conn.Exec("DELETE FROM aws_accounts WHERE id = $1", accountID)
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %v", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return ErrAccountNotFound
+	}
+	return nil
+}
+
+// scanAccount는 accountColumns 순서로 조회된 행을 계정 구조체로 변환합니다.
+func scanAccount(row interface {
+	Scan(dest ...interface{}) error
+}) (models.AWSAccount, error) {
+	var account models.AWSAccount
+	err := row.Scan(&account.ID, &account.Name, &account.AccountID, &account.RoleARN, &account.ExternalID,
+		pq.Array(&account.Regions), &account.CreatedAt)
+	return account, err
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// GetGroupActions는 리소스 그룹의 작업 기록을 최신순으로 페이지 단위 조회하고 전체 개수를 함께 반환합니다.
+// 각 기록에는 job_status에 저장된 작업 전체 상태가 포함됩니다.
+func (db *DB) GetGroupActions(groupID string, limit, offset int) ([]models.ActionLog, int, error) {
+	gid, err := strconv.Atoi(groupID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid groupID: %v", err)
+	}
+
+	var total int
+	err = db.Conn.QueryRow("SELECT COUNT(*) FROM action_logs WHERE group_id = $1", gid).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count actions: %v", err)
+	}
+
+	rows, err := db.Conn.Query(`
+		SELECT al.action_id, al.group_id, al.action_type, al.trigger_source, al.requested_by, al.created_at,
+		       js.status, js.message
+		FROM action_logs al
+		LEFT JOIN job_status js ON js.action_id = al.action_id AND js.resource_type IS NULL
+		WHERE al.group_id = $1
+		ORDER BY al.created_at DESC, al.action_id
+		LIMIT $2 OFFSET $3
+	`, gid, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query actions: %v", err)
+	}
+	defer rows.Close()
+
+	actions, err := scanActionLogs(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return actions, total, nil
+}
+
+// GetLatestGroupActions는 그룹별 가장 최근 작업 기록을 작업 전체 상태와 함께 반환합니다.
+// 작업 기록이 없는 그룹은 포함되지 않습니다.
+func (db *DB) GetLatestGroupActions() ([]models.ActionLog, error) {
+	rows, err := db.Conn.Query(`
+		SELECT DISTINCT ON (al.group_id)
+		       al.action_id, al.group_id, al.action_type, al.trigger_source, al.requested_by, al.created_at,
+		       js.status, js.message
+		FROM action_logs al
+		LEFT JOIN job_status js ON js.action_id = al.action_id AND js.resource_type IS NULL
+		ORDER BY al.group_id, al.created_at DESC, al.action_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest actions: %v", err)
+	}
+	defer rows.Close()
+
+	return scanActionLogs(rows)
+}
+
+// scanActionLogs는 작업 기록 조회 결과를 ActionLog 목록으로 변환합니다.
+func scanActionLogs(rows *sql.Rows) ([]models.ActionLog, error) {
+	actions := []models.ActionLog{}
+	for rows.Next() {
+		var (
+			action  models.ActionLog
+			status  sql.NullString
+			message sql.NullString
+		)
+		if err := rows.Scan(&action.ActionID, &action.GroupID, &action.Action, &action.Trigger, &action.RequestedBy,
+			&action.CreatedAt, &status, &message); err != nil {
+			return nil, fmt.Errorf("failed to scan action: %v", err)
+		}
+		action.Status = status.String
+		action.Message = message.String
+		actions = append(actions, action)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return actions, nil
+}
@@ -0,0 +1,185 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ErrCalendarNotFound는 요청한 휴일 캘린더가 존재하지 않을 때 반환됩니다.
+var ErrCalendarNotFound = errors.New("calendar not found")
+
+// AddCalendar는 휴일 캘린더와 휴일 목록을 저장하고 생성된 ID를 반환합니다.
+func (db *DB) AddCalendar(name string, holidays []models.Holiday) (int, error) {
+	tx, err := db.Conn.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+
+	var calendarID int
+	err = tx.QueryRow("INSERT INTO holiday_calendars (name) VALUES ($1) RETURNING id", name).Scan(&calendarID)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to add calendar: %v", err)
+	}
+
+	if err := insertHolidays(tx, calendarID, holidays); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return calendarID, nil
+}
+
+// ReplaceCalendarHolidays는 캘린더의 휴일 목록을 새 목록으로 교체합니다.
+func (db *DB) ReplaceCalendarHolidays(calendarID int, holidays []models.Holiday) error {
+	tx, err := db.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+
+	var exists bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM holiday_calendars WHERE id = $1)", calendarID).Scan(&exists)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to check if calendar exists: %v", err)
+	}
+	if !exists {
+		tx.Rollback()
+		return ErrCalendarNotFound
+	}
+
+	if _, err := tx.Exec("DELETE FROM holiday_calendar_dates WHERE calendar_id = $1", calendarID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear calendar dates: %v", err)
+	}
+
+	if err := insertHolidays(tx, calendarID, holidays); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
+
+// GetCalendars는 휴일 목록을 제외한 모든 휴일 캘린더를 반환합니다.
+func (db *DB) GetCalendars() ([]models.HolidayCalendar, error) {
+	rows, err := db.Conn.Query("SELECT id, name, created_at FROM holiday_calendars ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calendars: %v", err)
+	}
+	defer rows.Close()
+
+	calendars := []models.HolidayCalendar{}
+	for rows.Next() {
+		var calendar models.HolidayCalendar
+		if err := rows.Scan(&calendar.ID, &calendar.Name, &calendar.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar: %v", err)
+		}
+		calendars = append(calendars, calendar)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return calendars, nil
+}
+
+// GetCalendar는 휴일 목록을 포함한 특정 휴일 캘린더를 반환합니다.
+func (db *DB) GetCalendar(calendarID int) (models.HolidayCalendar, error) {
+	var calendar models.HolidayCalendar
+	err := db.Conn.QueryRow("SELECT id, name, created_at FROM holiday_calendars WHERE id = $1", calendarID).
+		Scan(&calendar.ID, &calendar.Name, &calendar.CreatedAt)
+	if err == sql.ErrNoRows {
+		return models.HolidayCalendar{}, ErrCalendarNotFound
+	}
+	if err != nil {
+		return models.HolidayCalendar{}, fmt.Errorf("failed to scan calendar: %v", err)
+	}
+
+	rows, err := db.Conn.Query(`
+		SELECT holiday_date, name
+		FROM holiday_calendar_dates
+		WHERE calendar_id = $1
+		ORDER BY holiday_date
+	`, calendarID)
+	if err != nil {
+		return models.HolidayCalendar{}, fmt.Errorf("failed to query calendar dates: %v", err)
+	}
+	defer rows.Close()
+
+	calendar.Holidays = []models.Holiday{}
+	for rows.Next() {
+		var (
+			date time.Time
+			name string
+		)
+		if err := rows.Scan(&date, &name); err != nil {
+			return models.HolidayCalendar{}, fmt.Errorf("failed to scan calendar date: %v", err)
+		}
+		calendar.Holidays = append(calendar.Holidays, models.Holiday{Date: date.Format(time.DateOnly), Name: name})
+	}
+
+	if err := rows.Err(); err != nil {
+		return models.HolidayCalendar{}, fmt.Errorf("error reading rows: %v", err)
+	}
+	return calendar, nil
+}
+
+// CalendarExists는 특정 휴일 캘린더가 데이터베이스에 존재하는지 확인합니다.
+func (db *DB) CalendarExists(calendarID int) (bool, error) {
+	var exists bool
+	err := db.Conn.QueryRow("SELECT EXISTS(SELECT 1 FROM holiday_calendars WHERE id = $1)", calendarID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if calendar exists: %v", err)
+	}
+	return exists, nil
+}
+
+// DeleteCalendar는 휴일 캘린더를 삭제합니다. 캘린더를 참조하던 스케줄은 캘린더 없이 유지됩니다.
+func (db *DB) DeleteCalendar(calendarID int) error {
+	result, err := db.Conn.Exec("DELETE FROM holiday_calendars WHERE id = $1", calendarID)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar: %v", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return ErrCalendarNotFound
+	}
+	return nil
+}
+
+// IsHoliday는 특정 날짜가 휴일 캘린더에 포함되어 있는지 확인합니다.
+// 날짜는 호출자가 스케줄의 타임존으로 변환한 값의 연/월/일만 사용합니다.
+func (db *DB) IsHoliday(calendarID int, day time.Time) (bool, error) {
+	var exists bool
+	query := "SELECT EXISTS(SELECT 1 FROM holiday_calendar_dates WHERE calendar_id = $1 AND holiday_date = $2)"
+	err := db.Conn.QueryRow(query, calendarID, day.Format(time.DateOnly)).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check holiday: %v", err)
+	}
+	return exists, nil
+}
+
+// insertHolidays는 트랜잭션 안에서 캘린더의 휴일 목록을 저장합니다. 중복 날짜는 무시합니다.
+func insertHolidays(tx *sql.Tx, calendarID int, holidays []models.Holiday) error {
+	query := `
+		INSERT INTO holiday_calendar_dates (calendar_id, holiday_date, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (calendar_id, holiday_date) DO NOTHING
+	`
+	for _, holiday := range holidays {
+		if _, err := tx.Exec(query, calendarID, holiday.Date, holiday.Name); err != nil {
+			return fmt.Errorf("failed to add calendar date %s: %v", holiday.Date, err)
+		}
+	}
+	return nil
+}
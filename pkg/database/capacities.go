@@ -0,0 +1,109 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// SaveServiceCapacity는 ECS 서비스의 중지 전 용량을 저장합니다. 이미 저장된 값이 있으면 덮어씁니다.
+func (db *DB) SaveServiceCapacity(capacity models.ServiceCapacity) error {
+	query := `
+		INSERT INTO ecs_service_capacities (cluster_arn, service_arn, desired_count, min_capacity, max_capacity)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (cluster_arn, service_arn) DO UPDATE
+		SET desired_count = EXCLUDED.desired_count,
+		    min_capacity = EXCLUDED.min_capacity,
+		    max_capacity = EXCLUDED.max_capacity,
+		    updated_at = CURRENT_TIMESTAMP
+	`
+	_, err := db.Conn.Exec(query, capacity.ClusterArn, capacity.ServiceArn, capacity.DesiredCount,
+		nullableInt32(capacity.MinCapacity), nullableInt32(capacity.MaxCapacity))
+	if err != nil {
+		return fmt.Errorf("failed to save service capacity: %v", err)
+	}
+	return nil
+}
+
+// GetServiceCapacity는 ECS 서비스의 저장된 용량을 반환합니다. 저장된 값이 없으면 found는 false입니다.
+func (db *DB) GetServiceCapacity(clusterArn, serviceArn string) (models.ServiceCapacity, bool, error) {
+	capacity := models.ServiceCapacity{ClusterArn: clusterArn, ServiceArn: serviceArn}
+	var minCapacity, maxCapacity sql.NullInt32
+
+	query := `
+		SELECT desired_count, min_capacity, max_capacity
+		FROM ecs_service_capacities
+		WHERE cluster_arn = $1 AND service_arn = $2
+	`
+	err := db.Conn.QueryRow(query, clusterArn, serviceArn).Scan(&capacity.DesiredCount, &minCapacity, &maxCapacity)
+	if err == sql.ErrNoRows {
+		return models.ServiceCapacity{}, false, nil
+	}
+	if err != nil {
+		return models.ServiceCapacity{}, false, fmt.Errorf("failed to get service capacity: %v", err)
+	}
+
+	if minCapacity.Valid {
+		capacity.MinCapacity = &minCapacity.Int32
+	}
+	if maxCapacity.Valid {
+		capacity.MaxCapacity = &maxCapacity.Int32
+	}
+	return capacity, true, nil
+}
+
+// SaveAutoScalingCapacity는 EC2 Auto Scaling 그룹의 중지 전 용량을 저장합니다. 이미 저장된 값이 있으면 덮어씁니다.
+func (db *DB) SaveAutoScalingCapacity(capacity models.AutoScalingCapacity) error {
+	query := `
+		INSERT INTO autoscaling_group_capacities (group_arn, group_name, min_size, max_size, desired_capacity)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (group_arn) DO UPDATE
+		SET group_name = EXCLUDED.group_name,
+		    min_size = EXCLUDED.min_size,
+		    max_size = EXCLUDED.max_size,
+		    desired_capacity = EXCLUDED.desired_capacity,
+		    updated_at = CURRENT_TIMESTAMP
+	`
+	_, err := db.Conn.Exec(query, capacity.GroupARN, capacity.GroupName, capacity.MinSize, capacity.MaxSize, capacity.DesiredCapacity)
+	if err != nil {
+		return fmt.Errorf("failed to save auto scaling group capacity: %v", err)
+	}
+	return nil
+}
+
+// GetAutoScalingCapacity는 EC2 Auto Scaling 그룹의 저장된 용량을 반환합니다. 저장된 값이 없으면 found는 false입니다.
+func (db *DB) GetAutoScalingCapacity(groupARN string) (models.AutoScalingCapacity, bool, error) {
+	capacity := models.AutoScalingCapacity{GroupARN: groupARN}
+
+	query := `
+		SELECT group_name, min_size, max_size, desired_capacity
+		FROM autoscaling_group_capacities
+		WHERE group_arn = $1
+	`
+	err := db.Conn.QueryRow(query, groupARN).Scan(&capacity.GroupName, &capacity.MinSize, &capacity.MaxSize, &capacity.DesiredCapacity)
+	if err == sql.ErrNoRows {
+		return models.AutoScalingCapacity{}, false, nil
+	}
+	if err != nil {
+		return models.AutoScalingCapacity{}, false, fmt.Errorf("failed to get auto scaling group capacity: %v", err)
+	}
+	return capacity, true, nil
+}
+
+// DeleteAutoScalingCapacity는 EC2 Auto Scaling 그룹의 저장된 용량을 삭제합니다. 저장된 값이 없어도 에러가 아닙니다.
+func (db *DB) DeleteAutoScalingCapacity(groupARN string) error {
+	_, err := db.Conn.Exec("DELETE FROM autoscaling_group_capacities WHERE group_arn = $1", groupARN)
+	if err != nil {
+		return fmt.Errorf("failed to delete auto scaling group capacity: %v", err)
+	}
+	return nil
+}
+
+// nullableInt32는 nil 포인터를 SQL NULL로 변환합니다.
+func nullableInt32(value *int32) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -2,12 +2,13 @@ package database
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 	"log"
 	"strconv"
 
-	_ "github.com/lib/pq" // PostgreSQL 드라이버
+	"github.com/lib/pq" // PostgreSQL 드라이버
 )
 
 // DB는 데이터베이스 연결을 나타내는 구조체입니다.
@@ -63,10 +64,12 @@ func (db *DB) GetGroupByID(groupID string) (map[string]interface{}, error) {
 
 	// LEFT JOIN을 사용하여 해당 그룹에 리소스가 없더라도 그룹 정보는 조회할 수 있도록 함
 	rows, err := db.Conn.Query(`
-        SELECT rg.id, rg.name, rg.status, rgr.resource_type, rgr.tag_key, rgr.tag_value
+        SELECT rg.id, rg.name, rg.status, rg.ecs_default_desired_count, rg.regions, rg.account_id, rgr.resource_type, rgr.tags,
+               rgr.name, rgr.start_order, rgr.depends_on, rgr.resource_ids, rgr.exclude_ids, rgr.regions
         FROM resource_groups rg
         LEFT JOIN resource_group_resources rgr ON rg.id = rgr.group_id
         WHERE rg.id = $1
+        ORDER BY rgr.id
     `, gid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query group and resources: %v", err)
@@ -77,18 +80,28 @@ func (db *DB) GetGroupByID(groupID string) (map[string]interface{}, error) {
 		groupIDVal  int
 		groupName   string
 		groupStatus string
-		resources   []map[string]interface{}
+		ecsDefault  int32
+		regions     []string
+		accountID   sql.NullInt64
+		resources   []models.AWSResource
 		foundGroup  bool
 	)
 
 	for rows.Next() {
 		var (
 			resourceType sql.NullString
-			tagKey       sql.NullString
-			tagValue     sql.NullString
+			tags         []byte
+			entryName    sql.NullString
+			startOrder   sql.NullInt64
+			dependsOn    []string
+			resourceIDs  []string
+			excludeIDs   []string
+			entryRegions []string
 		)
 
-		if err := rows.Scan(&groupIDVal, &groupName, &groupStatus, &resourceType, &tagKey, &tagValue); err != nil {
+		if err := rows.Scan(&groupIDVal, &groupName, &groupStatus, &ecsDefault, pq.Array(&regions), &accountID, &resourceType, &tags,
+			&entryName, &startOrder, pq.Array(&dependsOn), pq.Array(&resourceIDs), pq.Array(&excludeIDs),
+			pq.Array(&entryRegions)); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %v", err)
 		}
 
@@ -97,12 +110,21 @@ func (db *DB) GetGroupByID(groupID string) (map[string]interface{}, error) {
 		foundGroup = true
 
 		// 리소스 정보가 있는 경우에만 리소스 리스트에 추가
-		if resourceType.Valid && tagKey.Valid && tagValue.Valid {
-			resources = append(resources, map[string]interface{}{
-				"resource_type": resourceType.String,
-				"tag_key":       tagKey.String,
-				"tag_value":     tagValue.String,
-			})
+		if resourceType.Valid {
+			resource := models.AWSResource{
+				Type:      resourceType.String,
+				Tags:      []models.ResourceTag{},
+				Name:      entryName.String,
+				Order:     int(startOrder.Int64),
+				DependsOn: dependsOn,
+				IDs:       resourceIDs,
+				Exclude:   excludeIDs,
+				Regions:   entryRegions,
+			}
+			if err := json.Unmarshal(tags, &resource.Tags); err != nil {
+				return nil, fmt.Errorf("failed to decode resource tags: %v", err)
+			}
+			resources = append(resources, resource)
 		}
 	}
 
@@ -115,19 +137,32 @@ func (db *DB) GetGroupByID(groupID string) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("group not found")
 	}
 
+	// 계정이 지정되지 않은 그룹은 서버의 자격 증명을 사용
+	var account *int
+	if accountID.Valid {
+		id := int(accountID.Int64)
+		account = &id
+	}
+
 	// 최종 결과 반환
 	return map[string]interface{}{
-		"id":        groupIDVal,
-		"name":      groupName,
-		"status":    groupStatus,
-		"resources": resources,
+		"id":                        groupIDVal,
+		"name":                      groupName,
+		"status":                    groupStatus,
+		"ecs_default_desired_count": ecsDefault,
+		"regions":                   regions,
+		"account_id":                account,
+		"resources":                 resources,
 	}, nil
 }
 
-// RecordAction은 리소스 그룹의 작업 기록을 데이터베이스에 저장합니다.
-func (db *DB) RecordAction(actionID, groupID, actionType string) error {
-	query := "INSERT INTO action_logs (action_id, group_id, action_type) VALUES ($1, $2, $3)"
-	_, err := db.Conn.Exec(query, actionID, groupID, actionType)
+// RecordAction은 리소스 그룹의 작업 기록을 작업 실행 주체와 함께 데이터베이스에 저장합니다.
+func (db *DB) RecordAction(actionID, groupID, actionType string, origin models.ActionOrigin) error {
+	query := `
+		INSERT INTO action_logs (action_id, group_id, action_type, trigger_source, requested_by)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := db.Conn.Exec(query, actionID, groupID, actionType, origin.Trigger, origin.RequestedBy)
 	if err != nil {
 		return fmt.Errorf("failed to record action: %v", err)
 	}
@@ -145,13 +180,17 @@ func (db *DB) GroupExists(groupID string) (bool, error) {
 	return exists, nil
 }
 
-// GetActionStatus는 특정 작업의 상태를 반환합니다.
+// GetActionStatus는 특정 작업의 전체 상태와 리소스 항목별 진행 상태를 반환합니다.
 func (db *DB) GetActionStatus(actionID string) (map[string]interface{}, error) {
-	query := "SELECT action_id, group_id, action_type, created_at FROM action_logs WHERE action_id = $1"
+	query := `
+		SELECT action_id, group_id, action_type, trigger_source, requested_by, created_at
+		FROM action_logs
+		WHERE action_id = $1
+	`
 	row := db.Conn.QueryRow(query, actionID)
 
-	var actionIDRes, groupID, actionType, createdAt string
-	err := row.Scan(&actionIDRes, &groupID, &actionType, &createdAt)
+	var actionIDRes, groupID, actionType, trigger, requestedBy, createdAt string
+	err := row.Scan(&actionIDRes, &groupID, &actionType, &trigger, &requestedBy, &createdAt)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("action not found")
 	}
@@ -159,11 +198,32 @@ func (db *DB) GetActionStatus(actionID string) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("failed to scan action status: %v", err)
 	}
 
+	// 작업 전체 상태와 리소스 항목별 상태를 구분하여 응답에 포함
+	statuses, err := db.GetJobStatuses(actionID)
+	if err != nil {
+		return nil, err
+	}
+
+	overallStatus, message := models.JobStatusInProgress, ""
+	resources := []models.JobStatus{}
+	for _, jobStatus := range statuses {
+		if jobStatus.ResourceType == "" {
+			overallStatus, message = jobStatus.Status, jobStatus.Message
+			continue
+		}
+		resources = append(resources, jobStatus)
+	}
+
 	return map[string]interface{}{
-		"action_id":  actionIDRes,
-		"group_id":   groupID,
-		"action":     actionType,
-		"created_at": createdAt,
+		"action_id":    actionIDRes,
+		"group_id":     groupID,
+		"action":       actionType,
+		"trigger":      trigger,
+		"requested_by": requestedBy,
+		"status":       overallStatus,
+		"message":      message,
+		"resources":    resources,
+		"created_at":   createdAt,
 	}, nil
 }
 
@@ -182,31 +242,44 @@ func (db *DB) Close() error {
 }
 
 // AddResourceGroup는 새로운 리소스 그룹을 데이터베이스에 추가하고 생성된 ID를 반환합니다.
-func (db *DB) AddResourceGroup(name, status string, resources []models.AWSResource) (int, error) {
+func (db *DB) AddResourceGroup(group models.ResourceGroup, status string) (int, error) {
 	tx, err := db.Conn.Begin()
 	if err != nil {
 		return 0, fmt.Errorf("failed to begin transaction: %v", err)
 	}
 
 	var groupID int
-	query := "INSERT INTO resource_groups (name, status) VALUES ($1, $2) RETURNING id"
-	err = tx.QueryRow(query, name, status).Scan(&groupID)
+	query := "INSERT INTO resource_groups (name, status, ecs_default_desired_count, regions, account_id) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+	err = tx.QueryRow(query, group.Name, status, group.ECSDefaultDesiredCount, pq.Array(nonNilStrings(group.Regions)),
+		nullableInt(group.AccountID)).Scan(&groupID)
 	if err != nil {
 		tx.Rollback()
 		return 0, fmt.Errorf("failed to add resource group: %v", err)
 	}
 
-	for _, resource := range resources {
-		for _, tag := range resource.Tags {
-			query := `
-				INSERT INTO resource_group_resources (group_id, resource_type, tag_key, tag_value)
-				VALUES ($1, $2, $3, $4)
-			`
-			_, err = tx.Exec(query, groupID, resource.Type, tag.Key, tag.Value)
-			if err != nil {
-				tx.Rollback()
-				return 0, fmt.Errorf("failed to add resources to group: %v", err)
-			}
+	// 리소스 항목의 태그 목록은 하나의 선택자 (AND 조건) 로 한 행에 저장
+	for _, resource := range group.Resources {
+		resourceTags := resource.Tags
+		if resourceTags == nil {
+			resourceTags = []models.ResourceTag{}
+		}
+		tags, err := json.Marshal(resourceTags)
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to encode resource tags: %v", err)
+		}
+
+		dependsOn := nonNilStrings(resource.DependsOn)
+
+		query := `
+			INSERT INTO resource_group_resources (group_id, resource_type, tags, name, start_order, depends_on, resource_ids, exclude_ids, regions)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`
+		_, err = tx.Exec(query, groupID, resource.Type, tags, resource.Name, resource.Order, pq.Array(dependsOn),
+			pq.Array(nonNilStrings(resource.IDs)), pq.Array(nonNilStrings(resource.Exclude)), pq.Array(nonNilStrings(resource.Regions)))
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to add resources to group: %v", err)
 		}
 	}
 
@@ -217,6 +290,15 @@ func (db *DB) AddResourceGroup(name, status string, resources []models.AWSResour
 	return groupID, nil
 }
 
+// UpdateGroupStatus는 리소스 그룹의 상태 (running, stopped, partial, drifted) 를 갱신합니다.
+func (db *DB) UpdateGroupStatus(groupID, status string) error {
+	_, err := db.Conn.Exec("UPDATE resource_groups SET status = $1 WHERE id = $2", status, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to update group status: %v", err)
+	}
+	return nil
+}
+
 func (db *DB) DeleteResourceGroup(groupID string) error {
 	query := "DELETE FROM resource_groups WHERE id = $1"
 	_, err := db.Conn.Exec(query, groupID)
@@ -225,3 +307,11 @@ func (db *DB) DeleteResourceGroup(groupID string) error {
 	}
 	return nil
 }
+
+// nonNilStrings는 nil 슬라이스를 빈 슬라이스로 바꿔 NOT NULL 배열 컬럼에 저장할 수 있도록 합니다.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
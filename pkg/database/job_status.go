@@ -0,0 +1,93 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// CreateJobStatus는 작업의 상태 행을 생성하고 ID를 반환합니다.
+// resourceType이 빈 값이면 작업 전체 상태, 그 외에는 region에서 처리한 리소스 항목별 상태를 나타냅니다.
+func (db *DB) CreateJobStatus(actionID, resourceType, region, status, message string) (int, error) {
+	var id int
+	query := `
+		INSERT INTO job_status (action_id, resource_type, region, status, message)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	err := db.Conn.QueryRow(query, actionID, nullableString(resourceType), nullableString(region), status, message).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create job status: %v", err)
+	}
+	return id, nil
+}
+
+// UpdateJobStatus는 상태 행의 상태, 메시지, 처리 대상 리소스 ID 목록을 갱신합니다.
+func (db *DB) UpdateJobStatus(id int, status, message string, resourceIDs []string) error {
+	if resourceIDs == nil {
+		resourceIDs = []string{}
+	}
+
+	query := `
+		UPDATE job_status
+		SET status = $1, message = $2, resource_ids = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+	`
+	_, err := db.Conn.Exec(query, status, message, pq.Array(resourceIDs), id)
+	if err != nil {
+		return fmt.Errorf("failed to update job status: %v", err)
+	}
+	return nil
+}
+
+// GetJobStatuses는 작업의 모든 상태 행을 생성 순서대로 반환합니다.
+func (db *DB) GetJobStatuses(actionID string) ([]models.JobStatus, error) {
+	rows, err := db.Conn.Query(`
+		SELECT id, action_id, resource_type, region, resource_ids, status, message, created_at, updated_at
+		FROM job_status
+		WHERE action_id = $1
+		ORDER BY id
+	`, actionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job status: %v", err)
+	}
+	defer rows.Close()
+
+	var statuses []models.JobStatus
+	for rows.Next() {
+		var (
+			jobStatus    models.JobStatus
+			resourceType sql.NullString
+			region       sql.NullString
+			message      sql.NullString
+			updatedAt    sql.NullTime
+		)
+		if err := rows.Scan(&jobStatus.ID, &jobStatus.ActionID, &resourceType, &region, pq.Array(&jobStatus.ResourceIDs),
+			&jobStatus.Status, &message, &jobStatus.CreatedAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan job status: %v", err)
+		}
+		jobStatus.ResourceType = resourceType.String
+		jobStatus.Region = region.String
+		jobStatus.Message = message.String
+		jobStatus.UpdatedAt = updatedAt.Time
+		if jobStatus.ResourceIDs == nil {
+			jobStatus.ResourceIDs = []string{}
+		}
+		statuses = append(statuses, jobStatus)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return statuses, nil
+}
+
+// nullableString은 빈 문자열을 SQL NULL로 변환합니다.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,165 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ErrScheduleNotFound는 요청한 스케줄이 존재하지 않을 때 반환됩니다.
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+const scheduleColumns = "id, group_id, start_cron, stop_cron, timezone, enabled, calendar_id, created_at, updated_at"
+
+// AddSchedule는 리소스 그룹의 스케줄을 저장하고 생성된 스케줄을 반환합니다.
+func (db *DB) AddSchedule(groupID string, schedule models.Schedule) (models.Schedule, error) {
+	gid, err := strconv.Atoi(groupID)
+	if err != nil {
+		return models.Schedule{}, fmt.Errorf("invalid groupID: %v", err)
+	}
+
+	schedule.GroupID = gid
+	schedule.Enabled = true
+
+	query := `
+		INSERT INTO schedules (group_id, start_cron, stop_cron, timezone, enabled, calendar_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+	err = db.Conn.QueryRow(query, gid, schedule.StartCron, schedule.StopCron, schedule.Timezone, schedule.Enabled,
+		nullableInt(schedule.CalendarID)).Scan(&schedule.ID, &schedule.CreatedAt, &schedule.UpdatedAt)
+	if err != nil {
+		return models.Schedule{}, fmt.Errorf("failed to add schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+// GetEnabledSchedules는 활성화된 모든 스케줄을 반환합니다.
+func (db *DB) GetEnabledSchedules() ([]models.Schedule, error) {
+	return db.querySchedules("SELECT " + scheduleColumns + " FROM schedules WHERE enabled = TRUE ORDER BY id")
+}
+
+// GetSchedulesByGroup은 특정 리소스 그룹의 모든 스케줄을 반환합니다.
+func (db *DB) GetSchedulesByGroup(groupID string) ([]models.Schedule, error) {
+	gid, err := strconv.Atoi(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid groupID: %v", err)
+	}
+	return db.querySchedules("SELECT "+scheduleColumns+" FROM schedules WHERE group_id = $1 ORDER BY id", gid)
+}
+
+// GetSchedule은 리소스 그룹에 속한 특정 스케줄을 반환합니다.
+func (db *DB) GetSchedule(groupID string, scheduleID int) (models.Schedule, error) {
+	gid, err := strconv.Atoi(groupID)
+	if err != nil {
+		return models.Schedule{}, fmt.Errorf("invalid groupID: %v", err)
+	}
+
+	row := db.Conn.QueryRow("SELECT "+scheduleColumns+" FROM schedules WHERE id = $1 AND group_id = $2", scheduleID, gid)
+	schedule, err := scanSchedule(row)
+	if err == sql.ErrNoRows {
+		return models.Schedule{}, ErrScheduleNotFound
+	}
+	if err != nil {
+		return models.Schedule{}, fmt.Errorf("failed to scan schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+// ScheduleExists는 동일한 시작/중지 시각, 타임존, 휴일 캘린더를 가진 다른 스케줄이 그룹에 이미 있는지 확인합니다.
+// schedule.ID와 같은 스케줄 (변경 중인 스케줄 자신) 은 비교에서 제외합니다.
+func (db *DB) ScheduleExists(groupID string, schedule models.Schedule) (bool, error) {
+	var exists bool
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM schedules
+			WHERE group_id = $1 AND start_cron = $2 AND stop_cron = $3 AND timezone = $4
+			  AND calendar_id IS NOT DISTINCT FROM $5 AND id <> $6
+		)
+	`
+	err := db.Conn.QueryRow(query, groupID, schedule.StartCron, schedule.StopCron, schedule.Timezone,
+		nullableInt(schedule.CalendarID), schedule.ID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if schedule exists: %v", err)
+	}
+	return exists, nil
+}
+
+// UpdateSchedule는 스케줄의 cron 표현식, 타임존, 활성화 여부, 휴일 캘린더를 갱신하고 변경 시각을 schedule.UpdatedAt에 기록합니다.
+func (db *DB) UpdateSchedule(schedule *models.Schedule) error {
+	query := `
+		UPDATE schedules
+		SET start_cron = $1, stop_cron = $2, timezone = $3, enabled = $4, calendar_id = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6
+		RETURNING updated_at
+	`
+	err := db.Conn.QueryRow(query, schedule.StartCron, schedule.StopCron, schedule.Timezone, schedule.Enabled,
+		nullableInt(schedule.CalendarID), schedule.ID).Scan(&schedule.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrScheduleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %v", err)
+	}
+	return nil
+}
+
+// DeleteSchedule는 특정 스케줄을 삭제합니다.
+func (db *DB) DeleteSchedule(scheduleID int) error {
+	_, err := db.Conn.Exec("DELETE FROM schedules WHERE id = $1", scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %v", err)
+	}
+	return nil
+}
+
+// querySchedules는 스케줄 조회 쿼리를 실행하고 결과를 구조체 목록으로 변환합니다.
+func (db *DB) querySchedules(query string, args ...interface{}) ([]models.Schedule, error) {
+	rows, err := db.Conn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedules: %v", err)
+	}
+	defer rows.Close()
+
+	var schedules []models.Schedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule: %v", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading rows: %v", err)
+	}
+	return schedules, nil
+}
+
+// scanSchedule은 scheduleColumns 순서로 조회된 행을 스케줄 구조체로 변환합니다.
+func scanSchedule(row interface {
+	Scan(dest ...interface{}) error
+}) (models.Schedule, error) {
+	var (
+		schedule   models.Schedule
+		calendarID sql.NullInt64
+	)
+	err := row.Scan(&schedule.ID, &schedule.GroupID, &schedule.StartCron, &schedule.StopCron,
+		&schedule.Timezone, &schedule.Enabled, &calendarID, &schedule.CreatedAt, &schedule.UpdatedAt)
+	if calendarID.Valid {
+		id := int(calendarID.Int64)
+		schedule.CalendarID = &id
+	}
+	return schedule, err
+}
+
+// nullableInt는 nil 포인터를 SQL NULL로 변환합니다.
+func nullableInt(value *int) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
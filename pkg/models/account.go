@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// AWS 계정 구조체 (리소스 그룹이 다른 계정의 리소스를 제어할 때 STS AssumeRole로 맡을 역할)
+type AWSAccount struct {
+	ID         int       `json:"id"`                    // 계정 ID (CloudToggle 내부 ID)
+	Name       string    `json:"name"`                  // 계정 이름 (예: staging)
+	AccountID  string    `json:"aws_account_id"`        // 12자리 AWS 계정 ID
+	RoleARN    string    `json:"role_arn"`              // 맡을 IAM 역할 ARN
+	ExternalID string    `json:"external_id,omitempty"` // 역할의 신뢰 정책이 요구하는 외부 ID
+	Regions    []string  `json:"regions"`               // 계정의 기본 리전 목록 (그룹에 리전이 없을 때 사용)
+	CreatedAt  time.Time `json:"created_at"`            // 생성 시각
+}
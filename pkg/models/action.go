@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// 작업 유형
+const (
+	ActionStart = "start"
+	ActionStop  = "stop"
+)
+
+// 작업 실행 주체 (action_logs.trigger_source)
+const (
+	TriggerAPI       = "api"       // API를 통한 수동 요청
+	TriggerCron      = "cron"      // 스케줄에 의해 실행된 작업
+	TriggerOverride  = "override"  // 스케줄을 의도적으로 무시한 수동 요청
+	TriggerReconcile = "reconcile" // 원하는 상태와 다른 리소스를 되돌린 작업
+)
+
+// 작업 상태 (job_status.status)
+const (
+	JobStatusInProgress = "in_progress"
+	JobStatusCompleted  = "completed"
+	JobStatusFailed     = "failed"
+)
+
+// 작업의 전체 또는 리소스 항목별 진행 상태 구조체
+type JobStatus struct {
+	ID           int       `json:"id"`                      // 상태 ID
+	ActionID     string    `json:"action_id"`               // 작업 ID
+	ResourceType string    `json:"resource_type,omitempty"` // 리소스 유형 (빈 값이면 작업 전체 상태)
+	Region       string    `json:"region,omitempty"`        // 리소스 항목을 처리한 리전
+	ResourceIDs  []string  `json:"resource_ids"`            // 처리 대상 리소스 ID 목록
+	Status       string    `json:"status"`                  // in_progress, completed, failed
+	Message      string    `json:"message"`                 // 작업 관련 메시지 (예: 에러 원인)
+	CreatedAt    time.Time `json:"created_at"`              // 생성 시각
+	UpdatedAt    time.Time `json:"updated_at"`              // 마지막 갱신 시각
+}
+
+// 작업을 요청한 주체 구조체
+type ActionOrigin struct {
+	Trigger     string `json:"trigger"`      // api, cron, override, reconcile
+	RequestedBy string `json:"requested_by"` // JWT 사용자 이름 또는 스케줄 (예: schedule:3)
+}
+
+// 작업 기록 구조체 (action_logs)
+type ActionLog struct {
+	ActionID    string    `json:"action_id"`    // 작업 ID
+	GroupID     int       `json:"group_id"`     // 리소스 그룹 ID
+	Action      string    `json:"action"`       // start, stop
+	Trigger     string    `json:"trigger"`      // api, cron, override, reconcile
+	RequestedBy string    `json:"requested_by"` // 요청한 사용자 또는 스케줄
+	Status      string    `json:"status"`       // 작업 전체 상태
+	Message     string    `json:"message"`      // 작업 전체 메시지
+	CreatedAt   time.Time `json:"created_at"`   // 요청 시각
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// 휴일 캘린더 구조체 (캘린더에 포함된 날짜에는 스케줄의 시작 작업을 건너뜀)
+type HolidayCalendar struct {
+	ID        int       `json:"id"`                 // 캘린더 ID
+	Name      string    `json:"name"`               // 캘린더 이름 (예: kr-public-holidays)
+	Holidays  []Holiday `json:"holidays,omitempty"` // 휴일 목록
+	CreatedAt time.Time `json:"created_at"`         // 생성 시각
+}
+
+// 휴일 날짜 구조체
+type Holiday struct {
+	Date string `json:"date"`           // 날짜 (YYYY-MM-DD)
+	Name string `json:"name,omitempty"` // 휴일 이름 (예: 설날)
+}
@@ -0,0 +1,19 @@
+package models
+
+// ECS 서비스의 중지 전 용량 구조체
+type ServiceCapacity struct {
+	ClusterArn   string `json:"cluster_arn"`            // 클러스터 ARN 또는 이름
+	ServiceArn   string `json:"service_arn"`            // 서비스 ARN
+	DesiredCount int32  `json:"desired_count"`          // 중지 전 DesiredCount
+	MinCapacity  *int32 `json:"min_capacity,omitempty"` // 중지 전 Auto Scaling 최소 용량
+	MaxCapacity  *int32 `json:"max_capacity,omitempty"` // 중지 전 Auto Scaling 최대 용량
+}
+
+// EC2 Auto Scaling 그룹의 중지 전 용량 구조체
+type AutoScalingCapacity struct {
+	GroupARN        string `json:"group_arn"`        // Auto Scaling 그룹 ARN
+	GroupName       string `json:"group_name"`       // Auto Scaling 그룹 이름
+	MinSize         int32  `json:"min_size"`         // 중지 전 최소 크기
+	MaxSize         int32  `json:"max_size"`         // 중지 전 최대 크기
+	DesiredCapacity int32  `json:"desired_capacity"` // 중지 전 희망 용량
+}
@@ -0,0 +1,18 @@
+package models
+
+// 리소스 항목이 실제로 가리키는 AWS 리소스 구조체
+type ResourceInfo struct {
+	ID    string `json:"id"`             // 리소스 ID 또는 ARN (Start/Stop에 전달되는 값)
+	Name  string `json:"name,omitempty"` // 리소스 이름 (EC2 Name 태그, RDS 식별자, ECS 클러스터/서비스 이름)
+	State string `json:"state"`          // running, stopped, pending, unknown
+}
+
+// 작업 미리보기 (dry run) 에서 리소스 항목별로 확인된 리소스 목록 구조체
+type ResourcePreview struct {
+	Tier      int            `json:"tier"`            // 실행 단계 (1부터, 같은 단계는 병렬 실행)
+	Type      string         `json:"type"`            // 리소스 유형
+	Name      string         `json:"name,omitempty"`  // 리소스 항목 이름
+	Region    string         `json:"region"`          // 조회한 리전
+	Resources []ResourceInfo `json:"resources"`       // 확인된 리소스 목록
+	Error     string         `json:"error,omitempty"` // 리소스 조회에 실패한 경우 원인
+}
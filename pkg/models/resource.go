@@ -1,19 +1,49 @@
 package models
 
+// 리소스 그룹 상태 (resource_groups.status)
+const (
+	GroupStatusRunning = "running" // 모든 리소스가 실행 중
+	GroupStatusStopped = "stopped" // 모든 리소스가 중지됨
+	GroupStatusPartial = "partial" // 일부만 실행 중이거나 상태가 전환 중
+	GroupStatusDrifted = "drifted" // 원하는 상태와 다른 리소스가 있어 되돌리는 중
+)
+
 // AWS 리소스를 정의하는 구조체
 type AWSResource struct {
-	Type string        `json:"type"` // EC2, RDS, S3 등 AWS 리소스 유형
-	Tags []ResourceTag `json:"tags"` // 리소스를 필터링할 태그 목록
+	Type      string        `json:"type"`                 // EC2, RDS, S3 등 AWS 리소스 유형
+	Tags      []ResourceTag `json:"tags"`                 // 리소스를 필터링할 태그 목록
+	Name      string        `json:"name,omitempty"`       // 항목 이름 (depends_on에서 참조)
+	Order     int           `json:"order,omitempty"`      // 시작 순서 (작을수록 먼저 시작, 중지는 역순)
+	DependsOn []string      `json:"depends_on,omitempty"` // 이 항목보다 먼저 시작해야 하는 항목 이름 목록
+	IDs       []string      `json:"ids,omitempty"`        // 태그와 관계없이 포함할 리소스 ID 또는 ARN 목록
+	Exclude   []string      `json:"exclude,omitempty"`    // 결과에서 제외할 리소스 ID 또는 ARN 목록
+	Regions   []string      `json:"regions,omitempty"`    // 작업할 리전 목록 (비어 있으면 그룹의 리전)
 }
 
+// 태그 선택자 연산자 (ResourceTag.Operator)
+const (
+	TagOpEquals    = "equals"     // 값이 Value와 같음 (기본값)
+	TagOpNotEquals = "not_equals" // 태그가 없거나 값이 Value와 다름
+	TagOpExists    = "exists"     // 태그 키가 존재함
+	TagOpNotExists = "not_exists" // 태그 키가 존재하지 않음
+	TagOpIn        = "in"         // 값이 Values 중 하나와 같음
+	TagOpPrefix    = "prefix"     // 값이 Value로 시작함
+	TagOpGlob      = "glob"       // 값이 Value 패턴 (* 와 ? 와일드카드) 과 일치함
+)
+
 // 리소스 태그를 정의하는 구조체
 type ResourceTag struct {
-	Key   string `json:"key"`   // 태그 키 (예: Environment)
-	Value string `json:"value"` // 태그 값 (예: Development)
+	Key      string   `json:"key"`                // 태그 키 (예: Environment)
+	Value    string   `json:"value,omitempty"`    // 태그 값 (예: Development)
+	Operator string   `json:"operator,omitempty"` // 비교 연산자 (빈 값이면 equals)
+	Values   []string `json:"values,omitempty"`   // in 연산자의 값 목록
 }
 
 // 리소스 그룹 구조체 정의
 type ResourceGroup struct {
-	Name      string        `json:"name"`      // 리소스 그룹의 이름
-	Resources []AWSResource `json:"resources"` // 리소스 목록 (EC2, RDS 등)
+	Name                   string        `json:"name"`                      // 리소스 그룹의 이름
+	Resources              []AWSResource `json:"resources"`                 // 리소스 목록 (EC2, RDS 등)
+	ECSDefaultDesiredCount int32         `json:"ecs_default_desired_count"` // 저장된 용량이 없는 ECS 서비스의 시작 시 DesiredCount
+	Regions                []string      `json:"regions,omitempty"`         // 리소스 항목의 기본 리전 목록 (비어 있으면 계정 또는 서버의 기본 리전)
+	AccountID              *int          `json:"account_id,omitempty"`      // 리소스가 있는 AWS 계정 ID (비어 있으면 서버의 자격 증명 사용)
 }
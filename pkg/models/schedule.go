@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// 리소스 그룹의 시작/중지 스케줄 구조체
+type Schedule struct {
+	ID         int       `json:"id"`          // 스케줄 ID
+	GroupID    int       `json:"group_id"`    // 스케줄이 적용되는 리소스 그룹 ID
+	StartCron  string    `json:"start_cron"`  // 시작 작업 cron 표현식
+	StopCron   string    `json:"stop_cron"`   // 중지 작업 cron 표현식
+	Timezone   string    `json:"timezone"`    // IANA 타임존 이름 (빈 값이면 서버 로컬 시간)
+	Enabled    bool      `json:"enabled"`     // 스케줄 활성화 여부
+	CalendarID *int      `json:"calendar_id"` // 휴일 캘린더 ID (해당 날짜에는 시작 작업을 건너뜀)
+	CreatedAt  time.Time `json:"created_at"`  // 생성 시각
+	UpdatedAt  time.Time `json:"updated_at"`  // 마지막 변경 시각 (변경, 일시 중지, 재개)
+}
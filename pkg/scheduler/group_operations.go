@@ -1,7 +1,11 @@
 package scheduler
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"slices"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
@@ -10,114 +14,235 @@ import (
 
 // StartGroup은 특정 리소스 그룹의 인스턴스를 시작합니다.
 // 그룹 ID를 사용해 리소스를 조회하고, 리소스 타입별로 시작 작업을 실행합니다.
-func (s *Scheduler) StartGroup(resourceGroupID string) (string, error) {
-	actionID := uuid.New().String()
+// origin은 작업을 요청한 주체 (API 사용자, 스케줄 등) 로 작업 기록에 함께 저장됩니다.
+func (s *Scheduler) StartGroup(resourceGroupID string, origin models.ActionOrigin) (string, error) {
+	return s.dispatchAction(resourceGroupID, models.ActionStart, origin)
+}
 
-	go func() {
-		log.Printf("[Scheduler] Starting resources for group: %s", resourceGroupID)
+// StopGroup은 특정 리소스 그룹의 인스턴스를 중지합니다.
+// 그룹 ID를 사용해 리소스를 조회하고, 리소스 타입별로 중지 작업을 실행합니다.
+// origin은 작업을 요청한 주체 (API 사용자, 스케줄 등) 로 작업 기록에 함께 저장됩니다.
+func (s *Scheduler) StopGroup(resourceGroupID string, origin models.ActionOrigin) (string, error) {
+	return s.dispatchAction(resourceGroupID, models.ActionStop, origin)
+}
 
-		// 그룹 데이터를 가져와 리소스를 처리
-		resources, err := s.getResourcesForGroup(resourceGroupID)
-		if err != nil {
-			log.Printf("[Scheduler] Failed to get resources for group %s: %v", resourceGroupID, err)
-			return
-		}
+// dispatchAction은 작업 기록과 전체 진행 상태를 저장한 뒤 백그라운드에서 작업을 실행하고 작업 ID를 반환합니다.
+// 작업 상태는 job_status 테이블에 기록되므로 호출자는 작업 ID로 완료 여부를 조회할 수 있습니다.
+func (s *Scheduler) dispatchAction(resourceGroupID, action string, origin models.ActionOrigin) (string, error) {
+	actionID := uuid.New().String()
 
-		// 각 리소스에 대해 시작 작업 실행
-		for _, resource := range resources {
-			manager := s.getResourceManager(resource.Type)
-			if manager == nil {
-				log.Printf("[Scheduler] No manager found for resource type: %s", resource.Type)
-				continue
-			}
+	if origin.Trigger == "" {
+		origin.Trigger = models.TriggerAPI
+	}
+	if err := s.DB.RecordAction(actionID, resourceGroupID, action, origin); err != nil {
+		return "", err
+	}
 
-			resourceIDs, err := manager.GetByTags(s.Context, resource.Tags)
-			if err != nil {
-				log.Printf("[Scheduler] Failed to get resources for resource type %s: %v", resource.Type, err)
-				continue
-			}
+	statusID, err := s.DB.CreateJobStatus(actionID, "", "", models.JobStatusInProgress, "")
+	if err != nil {
+		return "", err
+	}
 
-			if len(resourceIDs) > 0 {
-				err = manager.Start(s.Context, resourceIDs)
-				if err != nil {
-					log.Printf("[Scheduler] Failed to start resources for resource type %s: %v", resource.Type, err)
-				} else {
-					log.Printf("[Scheduler] Successfully started resources: %v", resourceIDs)
-				}
-			} else {
-				log.Printf("[Scheduler] No matching resources found for resource type: %s", resource.Type)
-			}
-		}
-	}()
+	log.Printf("[Scheduler] Recorded %s action %s for group %s (trigger: %s, requested by: %q)",
+		action, actionID, resourceGroupID, origin.Trigger, origin.RequestedBy)
+	go s.runAction(actionID, statusID, resourceGroupID, action)
 
 	return actionID, nil
 }
 
-// StopGroup은 특정 리소스 그룹의 인스턴스를 중지합니다.
-// 그룹 ID를 사용해 리소스를 조회하고, 리소스 타입별로 중지 작업을 실행합니다.
-func (s *Scheduler) StopGroup(resourceGroupID string) (string, error) {
-	actionID := uuid.New().String()
+// runAction은 그룹의 리소스 항목별로 작업을 실행하고 진행 상태와 최종 결과를 기록합니다.
+func (s *Scheduler) runAction(actionID string, statusID int, resourceGroupID, action string) {
+	log.Printf("[Scheduler] Running %s for group: %s (action %s)", action, resourceGroupID, actionID)
 
-	go func() {
-		log.Printf("[Scheduler] Stopping resources for group: %s", resourceGroupID)
+	// 그룹 데이터를 가져와 리소스를 처리
+	group, err := s.getGroup(resourceGroupID)
+	if err != nil {
+		log.Printf("[Scheduler] Failed to get resources for group %s: %v", resourceGroupID, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, fmt.Sprintf("failed to get resources for group: %v", err), nil)
+		return
+	}
 
-		// 그룹 데이터를 가져와 리소스를 처리
-		resources, err := s.getResourcesForGroup(resourceGroupID)
-		if err != nil {
-			log.Printf("[Scheduler] Failed to get resources for group %s: %v", resourceGroupID, err)
-			return
-		}
+	target, err := s.resolveTarget(group)
+	if err != nil {
+		log.Printf("[Scheduler] Failed to get AWS account for group %s: %v", resourceGroupID, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, fmt.Sprintf("failed to get AWS account for group: %v", err), nil)
+		return
+	}
 
-		// 각 리소스에 대해 중지 작업 실행
-		for _, resource := range resources {
-			manager := s.getResourceManager(resource.Type)
-			if manager == nil {
-				log.Printf("[Scheduler] No manager found for resource type: %s", resource.Type)
-				continue
-			}
+	// 시작 순서와 의존 관계에 따라 단계별로 묶고, 중지는 역순으로 실행
+	tiers, err := planTiers(group.Resources)
+	if err != nil {
+		log.Printf("[Scheduler] Invalid resource order for group %s: %v", resourceGroupID, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, fmt.Sprintf("invalid resource order: %v", err), nil)
+		return
+	}
+	if action == models.ActionStop {
+		slices.Reverse(tiers)
+	}
 
-			resourceIDs, err := manager.GetByTags(s.Context, resource.Tags)
-			if err != nil {
-				log.Printf("[Scheduler] Failed to get resources for resource type %s: %v", resource.Type, err)
-				continue
+	// 같은 단계의 리소스 항목은 리전별로 병렬 실행하고, 한 단계가 실패하면 이후 단계는 건너뜀
+	failed, total := 0, 0
+	for i, tier := range tiers {
+		entries := expandRegions(target, tier)
+		total += len(entries)
+		if failed > 0 {
+			for _, entry := range entries {
+				s.recordSkippedResource(actionID, entry)
+				failed++
 			}
+			continue
+		}
 
-			if len(resourceIDs) > 0 {
-				err = manager.Stop(s.Context, resourceIDs)
-				if err != nil {
-					log.Printf("[Scheduler] Failed to stop resources for resource type %s: %v", resource.Type, err)
-				} else {
-					log.Printf("[Scheduler] Successfully stopped resources: %v", resourceIDs)
+		log.Printf("[Scheduler] Running %s tier %d/%d for group %s (%d resource entries)", action, i+1, len(tiers), resourceGroupID, len(entries))
+
+		var (
+			wg sync.WaitGroup
+			mu sync.Mutex
+		)
+		for _, entry := range entries {
+			wg.Add(1)
+			go func(entry regionalEntry) {
+				defer wg.Done()
+				if !s.runResourceAction(s.Context, actionID, action, entry) {
+					mu.Lock()
+					failed++
+					mu.Unlock()
 				}
-			} else {
-				log.Printf("[Scheduler] No matching resources found for resource type: %s", resource.Type)
-			}
+			}(entry)
 		}
-	}()
+		wg.Wait()
+	}
 
-	return actionID, nil
+	if failed > 0 {
+		message := fmt.Sprintf("%d of %d resource entries failed", failed, total)
+		log.Printf("[Scheduler] Action %s for group %s failed: %s", actionID, resourceGroupID, message)
+		s.updateJobStatus(statusID, models.JobStatusFailed, message, nil)
+		return
+	}
+
+	log.Printf("[Scheduler] Action %s for group %s completed", actionID, resourceGroupID)
+	s.updateJobStatus(statusID, models.JobStatusCompleted, fmt.Sprintf("%d resource entries processed", total), nil)
 }
 
-// getResourcesForGroup은 그룹 ID를 사용해 리소스 데이터를 가져옵니다.
-func (s *Scheduler) getResourcesForGroup(groupID string) ([]models.AWSResource, error) {
-	groupData, err := s.DB.GetGroupByID(groupID)
+// runResourceAction은 하나의 리소스 항목을 한 리전에서 실행하고 항목별 상태를 리전과 함께 기록합니다.
+// 작업이 실패한 경우 false를 반환합니다.
+func (s *Scheduler) runResourceAction(ctx context.Context, actionID, action string, entry regionalEntry) bool {
+	resource := entry.resource
+	statusID, err := s.DB.CreateJobStatus(actionID, resource.Type, entry.region, models.JobStatusInProgress, "")
+	if err != nil {
+		log.Printf("[Scheduler] Failed to record status for resource type %s in %s: %v", resource.Type, entry.region, err)
+	}
+
+	manager, err := getResourceManager(entry, action)
+	if err != nil {
+		log.Printf("[Scheduler] %v", err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, err.Error(), nil)
+		return false
+	}
+
+	resourceIDs, err := resolveResourceIDs(ctx, manager, entry)
+	if err != nil {
+		log.Printf("[Scheduler] Failed to get resources for resource type %s: %v", resource.Type, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, fmt.Sprintf("failed to get resources: %v", err), nil)
+		return false
+	}
+
+	if len(resourceIDs) == 0 {
+		log.Printf("[Scheduler] No matching resources found for resource type: %s", resource.Type)
+		s.updateJobStatus(statusID, models.JobStatusCompleted, "no matching resources found", resourceIDs)
+		return true
+	}
+
+	s.updateJobStatus(statusID, models.JobStatusInProgress, fmt.Sprintf("running %s on %d resource(s)", action, len(resourceIDs)), resourceIDs)
+
+	if action == models.ActionStart {
+		err = manager.Start(ctx, resourceIDs)
+	} else {
+		err = manager.Stop(ctx, resourceIDs)
+	}
 	if err != nil {
-		return nil, err
+		log.Printf("[Scheduler] Failed to %s resources for resource type %s: %v", action, resource.Type, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, err.Error(), resourceIDs)
+		return false
+	}
+
+	// 매니저가 상태 조회를 지원하면 모든 리소스가 목표 상태에 도달할 때까지 대기
+	if err := s.waitForTargetState(ctx, statusID, manager, action, resourceIDs); err != nil {
+		log.Printf("[Scheduler] Resources for resource type %s did not reach target state: %v", resource.Type, err)
+		s.updateJobStatus(statusID, models.JobStatusFailed, err.Error(), resourceIDs)
+		return false
 	}
 
-	return extractResources(groupData), nil
+	log.Printf("[Scheduler] Successfully ran %s on resources: %v", action, resourceIDs)
+	s.updateJobStatus(statusID, models.JobStatusCompleted, fmt.Sprintf("%s succeeded for %d resource(s)", action, len(resourceIDs)), resourceIDs)
+	return true
 }
 
-// getResourceManager는 리소스 유형에 맞는 매니저를 반환합니다.
-func (s *Scheduler) getResourceManager(resourceType string) aws.AWSResourceManager {
-	switch resourceType {
-	case "EC2":
-		return s.AWSClient.EC2Manager
-	case "ECS":
-		return s.AWSClient.ECSManager
-	case "RDS":
-		return s.AWSClient.RDSManager
-	default:
+// recordSkippedResource는 앞 단계가 실패하여 실행하지 않은 리소스 항목의 상태를 기록합니다.
+func (s *Scheduler) recordSkippedResource(actionID string, entry regionalEntry) {
+	resource := entry.resource
+	log.Printf("[Scheduler] Skipping resource type %s (%s) in %s: an earlier tier failed", resource.Type, resource.Name, entry.region)
+	if _, err := s.DB.CreateJobStatus(actionID, resource.Type, entry.region, models.JobStatusFailed, "skipped because an earlier tier failed"); err != nil {
+		log.Printf("[Scheduler] Failed to record status for resource type %s: %v", resource.Type, err)
+	}
+}
+
+// waitForTargetState는 작업 이후 리소스가 running (시작) 또는 stopped (중지) 상태가 될 때까지 기다립니다.
+// 매니저가 상태 조회를 지원하지 않거나 WaitTimeout이 0이면 바로 반환합니다.
+func (s *Scheduler) waitForTargetState(ctx context.Context, statusID int, manager aws.AWSResourceManager, action string, resourceIDs []string) error {
+	reader, ok := manager.(aws.ResourceStateReader)
+	if !ok || s.WaitTimeout <= 0 {
 		return nil
 	}
+
+	target := aws.StateRunning
+	if action == models.ActionStop {
+		target = aws.StateStopped
+	}
+
+	s.updateJobStatus(statusID, models.JobStatusInProgress,
+		fmt.Sprintf("waiting for %d resource(s) to become %s", len(resourceIDs), target), resourceIDs)
+
+	ctx, cancel := context.WithTimeout(ctx, s.WaitTimeout)
+	defer cancel()
+	return aws.WaitForState(ctx, reader, resourceIDs, target, s.WaitInterval)
+}
+
+// updateJobStatus는 상태 행을 갱신하고 실패 시 로그만 남깁니다. 상태 행 생성에 실패한 경우 (ID 0) 는 무시합니다.
+func (s *Scheduler) updateJobStatus(statusID int, status, message string, resourceIDs []string) {
+	if statusID == 0 {
+		return
+	}
+	if err := s.DB.UpdateJobStatus(statusID, status, message, resourceIDs); err != nil {
+		log.Printf("[Scheduler] Failed to update job status %d: %v", statusID, err)
+	}
+}
+
+// getGroup은 그룹 ID를 사용해 리소스 목록과 그룹 설정을 가져옵니다.
+func (s *Scheduler) getGroup(groupID string) (models.ResourceGroup, error) {
+	groupData, err := s.DB.GetGroupByID(groupID)
+	if err != nil {
+		return models.ResourceGroup{}, err
+	}
+
+	return extractGroup(groupData), nil
+}
+
+// getResourceManager는 레지스트리에 등록된 리소스 유형의 매니저를 항목의 클라이언트에서 가져오고 그룹 설정을 적용합니다.
+// action이 비어 있지 않으면 리소스 유형이 작업을 지원하는지도 확인합니다.
+func getResourceManager(entry regionalEntry, action string) (aws.AWSResourceManager, error) {
+	resourceType := entry.resource.Type
+	registered, ok := aws.LookupResourceType(resourceType)
+	manager := entry.client.Manager(resourceType)
+	if !ok || manager == nil {
+		return nil, fmt.Errorf("no manager found for resource type %s", resourceType)
+	}
+	if action != "" && !registered.SupportsAction(action) {
+		return nil, fmt.Errorf("resource type %s does not support %s", resourceType, action)
+	}
+	// 저장된 용량이 없는 ECS 서비스는 그룹의 기본 DesiredCount로 시작
+	if setter, ok := manager.(aws.DefaultDesiredCountSetter); ok {
+		manager = setter.WithDefaultDesiredCount(entry.ecsDefaultDesiredCount)
+	}
+	return manager, nil
 }
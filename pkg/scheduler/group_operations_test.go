@@ -0,0 +1,588 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws/fake"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// memoryStore는 테스트용 메모리 저장소입니다. 그룹, 스케줄, 휴일, 작업 기록과 작업 상태를 저장합니다.
+type memoryStore struct {
+	mu        sync.Mutex
+	groups    map[string]models.ResourceGroup
+	statuses  map[string]string // 그룹 ID별 resource_groups.status
+	schedules map[string][]models.Schedule
+	holidays  map[string]bool // 휴일 날짜 (2006-01-02)
+	actions   []models.ActionLog
+	jobs      []models.JobStatus
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		groups:    make(map[string]models.ResourceGroup),
+		statuses:  make(map[string]string),
+		schedules: make(map[string][]models.Schedule),
+		holidays:  make(map[string]bool),
+	}
+}
+
+func (m *memoryStore) addGroup(groupID string, group models.ResourceGroup) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groups[groupID] = group
+}
+
+func (m *memoryStore) addSchedule(groupID string, schedule models.Schedule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.schedules[groupID] = append(m.schedules[groupID], schedule)
+}
+
+func (m *memoryStore) addHoliday(day string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.holidays[day] = true
+}
+
+// addAction은 이미 끝난 작업 기록을 추가합니다.
+func (m *memoryStore) addAction(action models.ActionLog) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.actions = append(m.actions, action)
+}
+
+func (m *memoryStore) groupStatus(groupID string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.statuses[groupID]
+}
+
+// recordedActions는 기록된 작업을 기록 순서대로 반환합니다.
+func (m *memoryStore) recordedActions() []models.ActionLog {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.actions)
+}
+
+// jobStatuses는 작업의 상태 행을 생성 순서대로 반환합니다.
+func (m *memoryStore) jobStatuses(actionID string) []models.JobStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var statuses []models.JobStatus
+	for _, status := range m.jobs {
+		if status.ActionID == actionID {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func (m *memoryStore) GetAllGroups() ([]map[string]interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var groups []map[string]interface{}
+	for groupID, group := range m.groups {
+		groups = append(groups, map[string]interface{}{"id": groupID, "name": group.Name, "status": m.statuses[groupID]})
+	}
+	return groups, nil
+}
+
+func (m *memoryStore) GetGroupByID(groupID string) (map[string]interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	group, exists := m.groups[groupID]
+	if !exists {
+		return nil, fmt.Errorf("group not found")
+	}
+	return map[string]interface{}{
+		"name":                      group.Name,
+		"ecs_default_desired_count": group.ECSDefaultDesiredCount,
+		"regions":                   group.Regions,
+		"account_id":                group.AccountID,
+		"resources":                 group.Resources,
+	}, nil
+}
+
+func (m *memoryStore) UpdateGroupStatus(groupID, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.statuses[groupID] = status
+	return nil
+}
+
+func (m *memoryStore) GetAccount(accountID int) (models.AWSAccount, error) {
+	return models.AWSAccount{}, fmt.Errorf("account not found")
+}
+
+func (m *memoryStore) GetEnabledSchedules() ([]models.Schedule, error) {
+	return nil, nil
+}
+
+func (m *memoryStore) GetSchedulesByGroup(groupID string) ([]models.Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.schedules[groupID]), nil
+}
+
+func (m *memoryStore) IsHoliday(calendarID int, day time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.holidays[day.Format("2006-01-02")], nil
+}
+
+func (m *memoryStore) RecordAction(actionID, groupID, actionType string, origin models.ActionOrigin) error {
+	gid, err := strconv.Atoi(groupID)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.actions = append(m.actions, models.ActionLog{
+		ActionID:    actionID,
+		GroupID:     gid,
+		Action:      actionType,
+		Trigger:     origin.Trigger,
+		RequestedBy: origin.RequestedBy,
+		CreatedAt:   time.Now(),
+	})
+	return nil
+}
+
+// GetLatestGroupActions는 그룹별 마지막 작업과 작업 전체 상태를 반환합니다.
+func (m *memoryStore) GetLatestGroupActions() ([]models.ActionLog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	latest := make(map[int]models.ActionLog)
+	for _, action := range m.actions {
+		if previous, exists := latest[action.GroupID]; !exists || !action.CreatedAt.Before(previous.CreatedAt) {
+			latest[action.GroupID] = action
+		}
+	}
+
+	var actions []models.ActionLog
+	for _, action := range latest {
+		for _, status := range m.jobs {
+			if status.ActionID == action.ActionID && status.ResourceType == "" {
+				action.Status, action.Message = status.Status, status.Message
+				break
+			}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+func (m *memoryStore) CreateJobStatus(actionID, resourceType, region, status, message string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs = append(m.jobs, models.JobStatus{
+		ID:           len(m.jobs) + 1,
+		ActionID:     actionID,
+		ResourceType: resourceType,
+		Region:       region,
+		Status:       status,
+		Message:      message,
+	})
+	return len(m.jobs), nil
+}
+
+func (m *memoryStore) UpdateJobStatus(id int, status, message string, resourceIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id < 1 || id > len(m.jobs) {
+		return fmt.Errorf("job status %d not found", id)
+	}
+	m.jobs[id-1].Status, m.jobs[id-1].Message = status, message
+	if resourceIDs != nil {
+		m.jobs[id-1].ResourceIDs = resourceIDs
+	}
+	return nil
+}
+
+func newTestScheduler(store Store, clouds *fake.Clouds) *Scheduler {
+	s := NewScheduler(store, aws.NewAWSClientFromAPIs("us-east-1", nil, clouds.APIs))
+	s.WaitTimeout = 5 * time.Second
+	s.WaitInterval = time.Millisecond
+	return s
+}
+
+// waitForAction은 작업 전체 상태가 in_progress가 아니게 될 때까지 기다린 뒤 작업의 모든 상태 행을 반환합니다.
+func waitForAction(t *testing.T, store *memoryStore, actionID string) []models.JobStatus {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		statuses := store.jobStatuses(actionID)
+		if len(statuses) > 0 && statuses[0].Status != models.JobStatusInProgress {
+			return statuses
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("action %s did not finish", actionID)
+	return nil
+}
+
+func envTag(value string) []models.ResourceTag {
+	return []models.ResourceTag{{Key: "env", Value: value}}
+}
+
+// tieredGroup은 RDS → EC2 → ECS 순서로 시작하는 그룹입니다.
+func tieredGroup() models.ResourceGroup {
+	return models.ResourceGroup{
+		Name:                   "dev",
+		ECSDefaultDesiredCount: 2,
+		Resources: []models.AWSResource{
+			{Type: "ECS", Name: "web", Tags: envTag("dev"), DependsOn: []string{"app"}},
+			{Type: "EC2", Name: "app", Tags: envTag("dev"), DependsOn: []string{"db"}},
+			{Type: "RDS", Name: "db", Tags: envTag("dev")},
+		},
+	}
+}
+
+func TestStartGroup(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.SettleAfter = 2
+	cloud.AddDBInstance("db-1", false, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-dev", false, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-prod", false, map[string]string{"env": "prod"})
+	cloud.AddCluster("web", map[string]string{"env": "dev"})
+	cloud.AddService("web", "api", 0, nil)
+
+	store := newMemoryStore()
+	store.addGroup("1", tieredGroup())
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	statuses := waitForAction(t, store, actionID)
+
+	if statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("action status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+	for _, status := range statuses[1:] {
+		if status.Status != models.JobStatusCompleted || status.Region != "us-east-1" {
+			t.Errorf("%s entry status = %s in %q (%s), want completed in us-east-1", status.ResourceType, status.Status, status.Region, status.Message)
+		}
+	}
+
+	wantCalls := []string{"StartDBInstance db-1", "StartInstances i-dev", "UpdateService api=2"}
+	if calls := cloud.Calls(); !slices.Equal(calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", calls, wantCalls)
+	}
+	if state := cloud.DBInstanceStatus("db-1"); state != "available" {
+		t.Errorf("db-1 status = %s, want available", state)
+	}
+	if state := cloud.InstanceState("i-dev"); state != "running" {
+		t.Errorf("i-dev state = %s, want running", state)
+	}
+	if state := cloud.InstanceState("i-prod"); state != "stopped" {
+		t.Errorf("i-prod state = %s, want stopped", state)
+	}
+	if desired, running, _ := cloud.Service("web", "api"); desired != 2 || running != 2 {
+		t.Errorf("service api desired/running = %d/%d, want 2/2", desired, running)
+	}
+}
+
+func TestStopGroupRestoresCapacityOnStart(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddDBInstance("db-1", true, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-dev", true, map[string]string{"env": "dev"})
+	cloud.AddCluster("web", map[string]string{"env": "dev"})
+	cloud.AddService("web", "api", 3, nil)
+	cloud.AddScalableTarget("web", "api", 3, 6)
+
+	store := newMemoryStore()
+	store.addGroup("1", tieredGroup())
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StopGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StopGroup returned error: %v", err)
+	}
+	if statuses := waitForAction(t, store, actionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("stop status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	// 중지는 시작의 역순
+	wantCalls := []string{"RegisterScalableTarget service/web/api", "UpdateService api=0", "StopInstances i-dev", "StopDBInstance db-1"}
+	if calls := cloud.Calls(); !slices.Equal(calls, wantCalls) {
+		t.Errorf("stop calls = %v, want %v", calls, wantCalls)
+	}
+	if minCapacity, maxCapacity, suspended, _ := cloud.ScalableTarget("web", "api"); minCapacity != 0 || maxCapacity != 0 || !suspended {
+		t.Errorf("scalable target after stop = %d/%d suspended=%v, want 0/0 suspended", minCapacity, maxCapacity, suspended)
+	}
+
+	actionID, err = s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	if statuses := waitForAction(t, store, actionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("start status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	// 그룹의 기본값 (2) 대신 중지 전 DesiredCount와 Auto Scaling 용량을 복원
+	if desired, _, _ := cloud.Service("web", "api"); desired != 3 {
+		t.Errorf("service api desired count = %d, want 3", desired)
+	}
+	if minCapacity, maxCapacity, suspended, _ := cloud.ScalableTarget("web", "api"); minCapacity != 3 || maxCapacity != 6 || suspended {
+		t.Errorf("scalable target after start = %d/%d suspended=%v, want 3/6 resumed", minCapacity, maxCapacity, suspended)
+	}
+	if state := cloud.InstanceState("i-dev"); state != "running" {
+		t.Errorf("i-dev state = %s, want running", state)
+	}
+}
+
+func TestStartGroupSkipsLaterTiersOnFailure(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddDBInstance("db-1", false, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-dev", false, map[string]string{"env": "dev"})
+	cloud.FailOn("StartDBInstance", errors.New("throttled"))
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name: "dev",
+		Resources: []models.AWSResource{
+			{Type: "RDS", Name: "db", Tags: envTag("dev")},
+			{Type: "EC2", Name: "app", Tags: envTag("dev"), DependsOn: []string{"db"}},
+		},
+	})
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	statuses := waitForAction(t, store, actionID)
+
+	if statuses[0].Status != models.JobStatusFailed || statuses[0].Message != "2 of 2 resource entries failed" {
+		t.Errorf("action status = %s (%s), want failed (2 of 2 resource entries failed)", statuses[0].Status, statuses[0].Message)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("got %d status rows, want 3", len(statuses))
+	}
+	if rds := statuses[1]; rds.ResourceType != "RDS" || rds.Status != models.JobStatusFailed || !strings.Contains(rds.Message, "throttled") {
+		t.Errorf("RDS entry status = %+v, want failed with the injected error", rds)
+	}
+	if ec2 := statuses[2]; ec2.ResourceType != "EC2" || ec2.Status != models.JobStatusFailed || !strings.Contains(ec2.Message, "skipped") {
+		t.Errorf("EC2 entry status = %+v, want skipped", ec2)
+	}
+
+	if calls := cloud.Calls(); len(calls) != 0 {
+		t.Errorf("calls = %v, want none", calls)
+	}
+	if state := cloud.InstanceState("i-dev"); state != "stopped" {
+		t.Errorf("i-dev state = %s, want stopped", state)
+	}
+}
+
+func TestStartGroupAcrossRegions(t *testing.T) {
+	clouds := fake.NewClouds()
+	clouds.Region("us-east-1").AddInstance("i-east", false, map[string]string{"env": "dev"})
+	clouds.Region("eu-west-1").AddInstance("i-west", false, map[string]string{"env": "dev"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:      "dev",
+		Regions:   []string{"us-east-1", "eu-west-1"},
+		Resources: []models.AWSResource{{Type: "EC2", Tags: envTag("dev")}},
+	})
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	statuses := waitForAction(t, store, actionID)
+	if statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("action status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	var regions []string
+	for _, status := range statuses[1:] {
+		regions = append(regions, status.Region)
+	}
+	slices.Sort(regions)
+	if want := []string{"eu-west-1", "us-east-1"}; !slices.Equal(regions, want) {
+		t.Errorf("status regions = %v, want %v", regions, want)
+	}
+	if state := clouds.Region("us-east-1").InstanceState("i-east"); state != "running" {
+		t.Errorf("i-east state = %s, want running", state)
+	}
+	if state := clouds.Region("eu-west-1").InstanceState("i-west"); state != "running" {
+		t.Errorf("i-west state = %s, want running", state)
+	}
+}
+
+func TestStopGroupScalesAutoScalingGroupToZero(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.SettleAfter = 2
+	cloud.AddAutoScalingGroup("web", 1, 4, 2, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-web", true, map[string]string{"env": "dev", "aws:autoscaling:groupName": "web"})
+	cloud.AddInstance("i-dev", true, map[string]string{"env": "dev"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name: "dev",
+		Resources: []models.AWSResource{
+			{Type: "ASG", Name: "web", Tags: envTag("dev")},
+			{Type: "EC2", Name: "app", Tags: envTag("dev"), IDs: []string{"i-web"}, DependsOn: []string{"web"}},
+		},
+	})
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StopGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StopGroup returned error: %v", err)
+	}
+	if statuses := waitForAction(t, store, actionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("stop status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	// Auto Scaling 그룹의 인스턴스는 태그로 일치하든 ID로 지정하든 EC2 항목에서 제외되고 그룹 용량을 0으로 줄여 중지
+	wantCalls := []string{"StopInstances i-dev", "UpdateAutoScalingGroup web=0/0/0"}
+	if calls := cloud.Calls(); !slices.Equal(calls, wantCalls) {
+		t.Errorf("stop calls = %v, want %v", calls, wantCalls)
+	}
+	if _, _, _, instances, _ := cloud.AutoScalingGroup("web"); instances != 0 {
+		t.Errorf("auto scaling group instances after stop = %d, want 0", instances)
+	}
+
+	actionID, err = s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	if statuses := waitForAction(t, store, actionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("start status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	minSize, maxSize, desiredCapacity, instances, _ := cloud.AutoScalingGroup("web")
+	if minSize != 1 || maxSize != 4 || desiredCapacity != 2 || instances != 2 {
+		t.Errorf("auto scaling group after start = %d/%d/%d with %d instances, want 1/4/2 with 2 instances",
+			minSize, maxSize, desiredCapacity, instances)
+	}
+}
+
+func TestStartGroupFailsForAutoScalingGroupWithoutStoredCapacity(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddAutoScalingGroup("web", 0, 0, 0, map[string]string{"env": "dev"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:      "dev",
+		Resources: []models.AWSResource{{Type: "ASG", Name: "web", Tags: envTag("dev")}},
+	})
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	statuses := waitForAction(t, store, actionID)
+
+	// CloudToggle 밖에서 0으로 줄인 그룹은 복원할 용량이 없으므로 목표 상태를 기다리지 않고 실패
+	if statuses[0].Status != models.JobStatusFailed {
+		t.Errorf("action status = %s (%s), want failed", statuses[0].Status, statuses[0].Message)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("got %d status rows, want 2", len(statuses))
+	}
+	if asg := statuses[1]; asg.Status != models.JobStatusFailed || !strings.Contains(asg.Message, "auto scaling group web") {
+		t.Errorf("ASG entry status = %+v, want failed naming the group", asg)
+	}
+	if calls := cloud.Calls(); len(calls) != 0 {
+		t.Errorf("calls = %v, want none", calls)
+	}
+}
+
+func TestStartGroupRegistersSuspendedScalableTargetWithoutStoredCapacity(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddCluster("web", map[string]string{"env": "dev"})
+	cloud.AddService("web", "api", 0, nil)
+	cloud.AddScalableTarget("web", "api", 0, 0)
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:                   "dev",
+		ECSDefaultDesiredCount: 2,
+		Resources:              []models.AWSResource{{Type: "ECS", Name: "web", Tags: envTag("dev")}},
+	})
+	s := newTestScheduler(store, clouds)
+
+	actionID, err := s.StartGroup("1", models.ActionOrigin{})
+	if err != nil {
+		t.Fatalf("StartGroup returned error: %v", err)
+	}
+	if statuses := waitForAction(t, store, actionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("start status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	// 스케일링 대상을 먼저 복원해야 Auto Scaling이 DesiredCount를 최대 용량 0으로 되돌리지 않음
+	wantCalls := []string{"RegisterScalableTarget service/web/api", "UpdateService api=2"}
+	if calls := cloud.Calls(); !slices.Equal(calls, wantCalls) {
+		t.Errorf("start calls = %v, want %v", calls, wantCalls)
+	}
+	if minCapacity, maxCapacity, suspended, _ := cloud.ScalableTarget("web", "api"); minCapacity != 2 || maxCapacity != 2 || suspended {
+		t.Errorf("scalable target after start = %d/%d suspended=%v, want 2/2 resumed", minCapacity, maxCapacity, suspended)
+	}
+	if desired, _, _ := cloud.Service("web", "api"); desired != 2 {
+		t.Errorf("service api desired count = %d, want 2", desired)
+	}
+}
+
+func TestStartGroupRestoresAutoScalingGroupsAfterOneFails(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddAutoScalingGroup("legacy", 0, 0, 0, map[string]string{"env": "dev"})
+	cloud.AddAutoScalingGroup("web", 1, 4, 2, map[string]string{"env": "dev"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:      "dev",
+		Resources: []models.AWSResource{{Type: "ASG", Name: "web", Tags: envTag("dev")}},
+	})
+	s := newTestScheduler(store, clouds)
+
+	run := func(action func(string, models.ActionOrigin) (string, error)) []models.JobStatus {
+		t.Helper()
+		actionID, err := action("1", models.ActionOrigin{})
+		if err != nil {
+			t.Fatalf("action returned error: %v", err)
+		}
+		return waitForAction(t, store, actionID)
+	}
+
+	run(s.StopGroup)
+	statuses := run(s.StartGroup)
+
+	// legacy는 복원할 용량이 없어 실패하지만 web은 계속 복원
+	if statuses[0].Status != models.JobStatusFailed || !strings.Contains(statuses[1].Message, "auto scaling group legacy") {
+		t.Errorf("start statuses = %+v, want failed naming legacy", statuses)
+	}
+	if minSize, maxSize, desiredCapacity, _, _ := cloud.AutoScalingGroup("web"); minSize != 1 || maxSize != 4 || desiredCapacity != 2 {
+		t.Errorf("web after start = %d/%d/%d, want 1/4/2", minSize, maxSize, desiredCapacity)
+	}
+
+	// 시작 후 수동으로 바꾼 용량이 다음 중지/시작에서 유지됨
+	cloud.AddAutoScalingGroup("web", 2, 6, 3, map[string]string{"env": "dev"})
+	run(s.StopGroup)
+	run(s.StartGroup)
+	if minSize, maxSize, desiredCapacity, _, _ := cloud.AutoScalingGroup("web"); minSize != 2 || maxSize != 6 || desiredCapacity != 3 {
+		t.Errorf("web after resize and restart = %d/%d/%d, want 2/6/3", minSize, maxSize, desiredCapacity)
+	}
+}
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// ValidateResourceOrder는 리소스 항목의 depends_on이 존재하는 항목 이름을 가리키고 순환 의존이 없는지 확인합니다.
+func ValidateResourceOrder(resources []models.AWSResource) error {
+	_, err := planTiers(resources)
+	return err
+}
+
+// planTiers는 리소스 항목을 시작 순서대로 단계 (tier) 별로 묶어 반환합니다.
+// 항목의 단계 값은 order와 (의존하는 항목의 단계 값 + 1) 중 큰 값이며,
+// 같은 단계의 항목은 서로 독립적이므로 병렬로 실행할 수 있습니다. 중지 시에는 역순으로 실행합니다.
+func planTiers(resources []models.AWSResource) ([][]models.AWSResource, error) {
+	byName := make(map[string][]int)
+	for i, resource := range resources {
+		if resource.Name != "" {
+			byName[resource.Name] = append(byName[resource.Name], i)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	marks := make([]int, len(resources))
+	levels := make([]int, len(resources))
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch marks[i] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency involving resource %q", resources[i].Name)
+		}
+		marks[i] = visiting
+
+		level := resources[i].Order
+		for _, dependency := range resources[i].DependsOn {
+			indexes, exists := byName[dependency]
+			if !exists {
+				return fmt.Errorf("resource %q depends on unknown resource %q", resources[i].Name, dependency)
+			}
+			for _, j := range indexes {
+				if j == i {
+					return fmt.Errorf("resource %q depends on itself", resources[i].Name)
+				}
+				if err := visit(j); err != nil {
+					return err
+				}
+				level = max(level, levels[j]+1)
+			}
+		}
+
+		levels[i] = level
+		marks[i] = done
+		return nil
+	}
+
+	for i := range resources {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	tiersByLevel := make(map[int][]models.AWSResource)
+	var order []int
+	for i, resource := range resources {
+		if _, exists := tiersByLevel[levels[i]]; !exists {
+			order = append(order, levels[i])
+		}
+		tiersByLevel[levels[i]] = append(tiersByLevel[levels[i]], resource)
+	}
+	sort.Ints(order)
+
+	tiers := make([][]models.AWSResource, 0, len(order))
+	for _, level := range order {
+		tiers = append(tiers, tiersByLevel[level])
+	}
+	return tiers, nil
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"slices"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// PreviewGroup은 그룹의 리소스 항목별, 리전별로 작업 대상 리소스와 현재 상태를 조회합니다. Start/Stop은 호출하지 않습니다.
+// action이 중지이면 실제 중지 작업과 같이 단계를 역순으로 반환합니다.
+// 항목별 조회 실패는 해당 항목의 Error에 기록하고 나머지 항목은 계속 조회합니다.
+func (s *Scheduler) PreviewGroup(groupID, action string) ([]models.ResourcePreview, error) {
+	group, err := s.getGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.resolveTarget(group)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := planTiers(group.Resources)
+	if err != nil {
+		return nil, err
+	}
+	if action == models.ActionStop {
+		slices.Reverse(tiers)
+	}
+
+	previews := []models.ResourcePreview{}
+	for i, tier := range tiers {
+		for _, entry := range expandRegions(target, tier) {
+			preview := models.ResourcePreview{
+				Tier:      i + 1,
+				Type:      entry.resource.Type,
+				Name:      entry.resource.Name,
+				Region:    entry.region,
+				Resources: []models.ResourceInfo{},
+			}
+
+			resources, err := s.describeResources(entry)
+			if err != nil {
+				preview.Error = err.Error()
+			} else {
+				preview.Resources = resources
+			}
+			previews = append(previews, preview)
+		}
+	}
+	return previews, nil
+}
+
+// describeResources는 리전별 리소스 항목의 대상 리소스 ID를 확인하고 이름과 상태를 조회합니다.
+// 매니저가 ResourceDescriber를 구현하지 않으면 상태를 unknown으로 표시합니다.
+func (s *Scheduler) describeResources(entry regionalEntry) ([]models.ResourceInfo, error) {
+	manager, err := getResourceManager(entry, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resourceIDs, err := resolveResourceIDs(s.Context, manager, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if describer, ok := manager.(aws.ResourceDescriber); ok {
+		return describer.Describe(s.Context, resourceIDs)
+	}
+
+	infos := make([]models.ResourceInfo, 0, len(resourceIDs))
+	for _, id := range resourceIDs {
+		infos = append(infos, models.ResourceInfo{ID: id, State: aws.StateUnknown})
+	}
+	return infos, nil
+}
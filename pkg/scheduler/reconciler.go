@@ -0,0 +1,306 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// reconcileRequestedBy는 리컨사일러가 기록하는 작업의 요청자 값입니다.
+const reconcileRequestedBy = "reconciler"
+
+// previousRunWindows는 스케줄의 직전 실행 시각을 찾을 때 차례로 넓혀 가며 살펴보는 기간입니다.
+var previousRunWindows = []time.Duration{24 * time.Hour, 8 * 24 * time.Hour, 32 * 24 * time.Hour, 366 * 24 * time.Hour}
+
+// driftedEntry는 원하는 상태와 다른 리전별 리소스 항목과 되돌릴 리소스 ID 목록입니다.
+type driftedEntry struct {
+	regionalEntry
+	manager     aws.AWSResourceManager
+	resourceIDs []string
+}
+
+// runReconciler는 ReconcileInterval마다 reconcile을 실행합니다. ctx가 취소되면 종료합니다.
+func (s *Scheduler) runReconciler(ctx context.Context) {
+	log.Printf("[Scheduler] Reconciler started (interval: %s)", s.ReconcileInterval)
+	ticker := time.NewTicker(s.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("[Scheduler] Reconciler stopped")
+			return
+		case <-ticker.C:
+			s.reconcile(ctx)
+		}
+	}
+}
+
+// reconcile은 모든 그룹의 원하는 상태와 실제 상태를 비교하여 차이를 되돌리고 그룹 상태를 갱신합니다.
+func (s *Scheduler) reconcile(ctx context.Context) {
+	groups, err := s.DB.GetAllGroups()
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to load groups: %v", err)
+		return
+	}
+
+	actions, err := s.DB.GetLatestGroupActions()
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to load latest actions: %v", err)
+		return
+	}
+	latest := make(map[string]models.ActionLog, len(actions))
+	for _, action := range actions {
+		latest[strconv.Itoa(action.GroupID)] = action
+	}
+
+	now := time.Now()
+	for _, group := range groups {
+		groupID, _ := group["id"].(string)
+		var lastAction *models.ActionLog
+		if action, exists := latest[groupID]; exists {
+			lastAction = &action
+		}
+		s.reconcileGroup(ctx, groupID, lastAction, now)
+	}
+}
+
+// reconcileGroup은 하나의 그룹을 점검합니다. 마지막 작업이 아직 진행 중인 그룹은 건너뜁니다.
+// 원하는 상태와 반대 상태 (running/stopped) 인 리소스가 있으면 drifted로 표시하고 해당 리소스만 되돌리며,
+// 그 외에는 실제 상태에 따라 running, stopped, partial로 갱신합니다. 대상 리소스가 없으면 상태를 바꾸지 않습니다.
+func (s *Scheduler) reconcileGroup(ctx context.Context, groupID string, lastAction *models.ActionLog, now time.Time) {
+	if lastAction != nil && lastAction.Status == models.JobStatusInProgress {
+		return
+	}
+
+	desired, err := s.desiredState(groupID, lastAction, now)
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to compute desired state of group %s: %v", groupID, err)
+		return
+	}
+
+	group, err := s.getGroup(groupID)
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to get group %s: %v", groupID, err)
+		return
+	}
+	target, err := s.resolveTarget(group)
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to get AWS account for group %s: %v", groupID, err)
+		return
+	}
+	tiers, err := planTiers(group.Resources)
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler skipped group %s: invalid resource order: %v", groupID, err)
+		return
+	}
+	if desired == models.ActionStop {
+		slices.Reverse(tiers)
+	}
+
+	// 되돌릴 때 시작은 의존 순서, 중지는 역순이 되도록 단계 순서대로 점검
+	var (
+		drifted                 []driftedEntry
+		running, stopped, other int
+	)
+	for _, tier := range tiers {
+		for _, entry := range expandRegions(target, tier) {
+			manager, resourceIDs, states, err := s.observeResources(ctx, entry)
+			if err != nil {
+				log.Printf("[Scheduler] Reconciler failed to check %s resources in %s for group %s: %v", entry.resource.Type, entry.region, groupID, err)
+				other++
+				continue
+			}
+
+			var driftedIDs []string
+			for _, id := range resourceIDs {
+				state, exists := states[id]
+				if !exists {
+					continue
+				}
+				switch state {
+				case aws.StateRunning:
+					running++
+					if desired == models.ActionStop {
+						driftedIDs = append(driftedIDs, id)
+					}
+				case aws.StateStopped:
+					stopped++
+					if desired == models.ActionStart {
+						driftedIDs = append(driftedIDs, id)
+					}
+				default:
+					other++
+				}
+			}
+			// 원하는 작업을 지원하지 않는 리소스 유형은 되돌리지 않음
+			if registered, _ := aws.LookupResourceType(entry.resource.Type); len(driftedIDs) > 0 && registered.SupportsAction(desired) {
+				drifted = append(drifted, driftedEntry{regionalEntry: entry, manager: manager, resourceIDs: driftedIDs})
+			}
+		}
+	}
+
+	status := groupStatus(running, stopped, other, len(drifted) > 0)
+	if status == "" {
+		return
+	}
+	if err := s.DB.UpdateGroupStatus(groupID, status); err != nil {
+		log.Printf("[Scheduler] Reconciler failed to update status of group %s: %v", groupID, err)
+	}
+
+	if len(drifted) > 0 {
+		s.correctDrift(ctx, groupID, desired, drifted)
+	}
+}
+
+// desiredState는 그룹의 원하는 상태 (ActionStart 또는 ActionStop) 를 계산합니다.
+// 마지막 작업과 활성 스케줄의 직전 시작/중지 시각 중 가장 최근의 것을 따르며, 둘 다 없으면 빈 값을 반환합니다.
+// 휴일이라 건너뛴 시작 시각과, 스케줄이 생성되거나 마지막으로 변경 (일시 중지, 재개 포함) 되기 전의 시각은
+// 스케줄이 실제로 실행한 적이 없으므로 제외합니다.
+func (s *Scheduler) desiredState(groupID string, lastAction *models.ActionLog, now time.Time) (string, error) {
+	var (
+		desired string
+		at      time.Time
+	)
+	if lastAction != nil {
+		desired, at = lastAction.Action, lastAction.CreatedAt
+	}
+
+	schedules, err := s.DB.GetSchedulesByGroup(groupID)
+	if err != nil {
+		return "", err
+	}
+	for _, schedule := range schedules {
+		if !schedule.Enabled {
+			continue
+		}
+		since := schedule.CreatedAt
+		if schedule.UpdatedAt.After(since) {
+			since = schedule.UpdatedAt
+		}
+		if stopAt, ok := previousRun(schedule.StopCron, schedule.Timezone, now); ok && stopAt.After(at) && !stopAt.Before(since) {
+			desired, at = models.ActionStop, stopAt
+		}
+		if startAt, ok := previousRun(schedule.StartCron, schedule.Timezone, now); ok && startAt.After(at) && !startAt.Before(since) &&
+			!s.isHoliday(schedule, startAt) {
+			desired, at = models.ActionStart, startAt
+		}
+	}
+	return desired, nil
+}
+
+// previousRun은 cron 표현식이 now 이전에 마지막으로 실행된 시각을 반환합니다.
+// cron 라이브러리는 이전 실행 시각을 제공하지 않으므로 과거 시점부터 Next를 반복하여 찾습니다.
+func previousRun(expression, timezone string, now time.Time) (time.Time, bool) {
+	schedule, err := cronParser.Parse(cronSpec(expression, timezone))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	for _, window := range previousRunWindows {
+		last := schedule.Next(now.Add(-window))
+		if last.IsZero() || last.After(now) {
+			continue
+		}
+		for {
+			next := schedule.Next(last)
+			if next.IsZero() || next.After(now) {
+				return last, true
+			}
+			last = next
+		}
+	}
+	return time.Time{}, false
+}
+
+// observeResources는 리전별 리소스 항목에 해당하는 리소스 ID와 현재 상태를 조회합니다.
+func (s *Scheduler) observeResources(ctx context.Context, entry regionalEntry) (aws.AWSResourceManager, []string, map[string]string, error) {
+	manager, err := getResourceManager(entry, "")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	reader, ok := manager.(aws.ResourceStateReader)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("no state reader for resource type %s", entry.resource.Type)
+	}
+
+	resourceIDs, err := resolveResourceIDs(ctx, manager, entry)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(resourceIDs) == 0 {
+		return manager, nil, nil, nil
+	}
+
+	states, err := reader.GetStates(ctx, resourceIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return manager, resourceIDs, states, nil
+}
+
+// groupStatus는 리소스 상태 개수로 그룹 상태를 결정합니다. 점검한 리소스가 없으면 빈 값을 반환합니다.
+func groupStatus(running, stopped, other int, drifted bool) string {
+	switch {
+	case drifted:
+		return models.GroupStatusDrifted
+	case running+stopped+other == 0:
+		return ""
+	case stopped == 0 && other == 0:
+		return models.GroupStatusRunning
+	case running == 0 && other == 0:
+		return models.GroupStatusStopped
+	default:
+		return models.GroupStatusPartial
+	}
+}
+
+// correctDrift는 원하는 상태와 다른 리소스만 시작 또는 중지하고 reconcile 작업으로 기록합니다.
+func (s *Scheduler) correctDrift(ctx context.Context, groupID, action string, drifted []driftedEntry) {
+	actionID := uuid.New().String()
+	origin := models.ActionOrigin{Trigger: models.TriggerReconcile, RequestedBy: reconcileRequestedBy}
+	if err := s.DB.RecordAction(actionID, groupID, action, origin); err != nil {
+		log.Printf("[Scheduler] Reconciler failed to record action for group %s: %v", groupID, err)
+		return
+	}
+	statusID, err := s.DB.CreateJobStatus(actionID, "", "", models.JobStatusInProgress, "")
+	if err != nil {
+		log.Printf("[Scheduler] Reconciler failed to record status for group %s: %v", groupID, err)
+	}
+
+	failed := 0
+	for _, entry := range drifted {
+		log.Printf("[Scheduler] Reconciler running %s on drifted %s resources in %s for group %s: %v", action, entry.resource.Type, entry.region, groupID, entry.resourceIDs)
+		entryStatusID, err := s.DB.CreateJobStatus(actionID, entry.resource.Type, entry.region, models.JobStatusInProgress, "")
+		if err != nil {
+			log.Printf("[Scheduler] Failed to record status for resource type %s: %v", entry.resource.Type, err)
+		}
+
+		if action == models.ActionStart {
+			err = entry.manager.Start(ctx, entry.resourceIDs)
+		} else {
+			err = entry.manager.Stop(ctx, entry.resourceIDs)
+		}
+		if err != nil {
+			log.Printf("[Scheduler] Reconciler failed to %s %s resources in group %s: %v", action, entry.resource.Type, groupID, err)
+			s.updateJobStatus(entryStatusID, models.JobStatusFailed, err.Error(), entry.resourceIDs)
+			failed++
+			continue
+		}
+		s.updateJobStatus(entryStatusID, models.JobStatusCompleted,
+			fmt.Sprintf("corrected %d drifted resource(s)", len(entry.resourceIDs)), entry.resourceIDs)
+	}
+
+	if failed > 0 {
+		s.updateJobStatus(statusID, models.JobStatusFailed, fmt.Sprintf("%d of %d resource entries failed", failed, len(drifted)), nil)
+		return
+	}
+	s.updateJobStatus(statusID, models.JobStatusCompleted, fmt.Sprintf("%d drifted resource entries corrected", len(drifted)), nil)
+}
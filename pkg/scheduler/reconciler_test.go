@@ -0,0 +1,320 @@
+package scheduler
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws/fake"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func utc(year int, month time.Month, day, hour, minute, second int) time.Time {
+	return time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+}
+
+func TestPreviousRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timezone   string
+		now        time.Time
+		want       time.Time
+		wantOK     bool
+	}{
+		{
+			name:       "every minute",
+			expression: "0 * * * * *",
+			timezone:   "UTC",
+			now:        utc(2025, 1, 10, 9, 0, 0).Add(30 * time.Second),
+			want:       utc(2025, 1, 10, 9, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "run at now counts",
+			expression: "0 0 9 * * *",
+			timezone:   "UTC",
+			now:        utc(2025, 1, 10, 9, 0, 0),
+			want:       utc(2025, 1, 10, 9, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "weekdays only on sunday",
+			expression: "0 0 9 * * MON-FRI",
+			timezone:   "UTC",
+			now:        utc(2025, 1, 12, 12, 0, 0),
+			want:       utc(2025, 1, 10, 9, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "timezone after local run",
+			expression: "0 0 9 * * *",
+			timezone:   "Asia/Seoul",
+			now:        utc(2025, 1, 10, 1, 0, 0), // 10:00 KST
+			want:       utc(2025, 1, 10, 0, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "timezone before local run",
+			expression: "0 0 9 * * *",
+			timezone:   "Asia/Seoul",
+			now:        utc(2025, 1, 9, 23, 0, 0), // 다음 날 08:00 KST
+			want:       utc(2025, 1, 9, 0, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "day after DST starts",
+			expression: "0 0 9 * * *",
+			timezone:   "America/New_York",
+			now:        utc(2025, 3, 10, 12, 0, 0), // 08:00 EDT
+			want:       utc(2025, 3, 9, 13, 0, 0),  // 09:00 EDT
+			wantOK:     true,
+		},
+		{
+			name:       "day after DST ends",
+			expression: "0 0 9 * * *",
+			timezone:   "America/New_York",
+			now:        utc(2025, 11, 3, 13, 0, 0), // 08:00 EST
+			want:       utc(2025, 11, 2, 14, 0, 0), // 09:00 EST
+			wantOK:     true,
+		},
+		{
+			name:       "weekly run found in a wider window",
+			expression: "0 0 9 * * MON",
+			timezone:   "UTC",
+			now:        utc(2025, 1, 12, 12, 0, 0),
+			want:       utc(2025, 1, 6, 9, 0, 0),
+			wantOK:     true,
+		},
+		{
+			name:       "no run in 366 days",
+			expression: "0 0 0 29 2 *",
+			timezone:   "UTC",
+			now:        utc(2025, 6, 1, 0, 0, 0),
+			wantOK:     false,
+		},
+		{
+			name:       "invalid expression",
+			expression: "not a cron",
+			now:        utc(2025, 6, 1, 0, 0, 0),
+			wantOK:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := previousRun(tt.expression, tt.timezone, tt.now)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("previousRun = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// officeHours는 평일 09:00 시작, 18:00 중지 (UTC) 스케줄입니다.
+func officeHours(createdAt time.Time) models.Schedule {
+	return models.Schedule{
+		ID:        1,
+		GroupID:   1,
+		StartCron: "0 0 9 * * MON-FRI",
+		StopCron:  "0 0 18 * * MON-FRI",
+		Timezone:  "UTC",
+		Enabled:   true,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+func TestDesiredState(t *testing.T) {
+	now := utc(2025, 1, 10, 15, 0, 0) // 금요일
+	longAgo := utc(2024, 12, 1, 0, 0, 0)
+	calendarID := 1
+
+	tests := []struct {
+		name       string
+		schedules  []models.Schedule
+		lastAction *models.ActionLog
+		holiday    string
+		want       string
+	}{
+		{
+			name: "no action and no schedule",
+			want: "",
+		},
+		{
+			name:      "schedule start after previous stop",
+			schedules: []models.Schedule{officeHours(longAgo)},
+			want:      models.ActionStart,
+		},
+		{
+			name:       "manual stop after scheduled start",
+			schedules:  []models.Schedule{officeHours(longAgo)},
+			lastAction: &models.ActionLog{Action: models.ActionStop, CreatedAt: utc(2025, 1, 10, 12, 0, 0)},
+			want:       models.ActionStop,
+		},
+		{
+			name:       "scheduled start after manual stop",
+			schedules:  []models.Schedule{officeHours(longAgo)},
+			lastAction: &models.ActionLog{Action: models.ActionStop, CreatedAt: utc(2025, 1, 10, 8, 0, 0)},
+			want:       models.ActionStart,
+		},
+		{
+			name: "holiday skips start",
+			schedules: []models.Schedule{func() models.Schedule {
+				schedule := officeHours(longAgo)
+				schedule.CalendarID = &calendarID
+				return schedule
+			}()},
+			holiday: "2025-01-10",
+			want:    models.ActionStop,
+		},
+		{
+			name: "disabled schedule",
+			schedules: []models.Schedule{func() models.Schedule {
+				schedule := officeHours(longAgo)
+				schedule.Enabled = false
+				return schedule
+			}()},
+			lastAction: &models.ActionLog{Action: models.ActionStop, CreatedAt: utc(2025, 1, 9, 20, 0, 0)},
+			want:       models.ActionStop,
+		},
+		{
+			name:      "schedule created after its last runs",
+			schedules: []models.Schedule{officeHours(utc(2025, 1, 10, 14, 0, 0))},
+			want:      "",
+		},
+		{
+			name: "schedule resumed after today's start",
+			schedules: []models.Schedule{func() models.Schedule {
+				schedule := officeHours(longAgo)
+				schedule.UpdatedAt = utc(2025, 1, 10, 10, 0, 0)
+				return schedule
+			}()},
+			lastAction: &models.ActionLog{Action: models.ActionStop, CreatedAt: utc(2025, 1, 9, 20, 0, 0)},
+			want:       models.ActionStop,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			for _, schedule := range tt.schedules {
+				store.addSchedule("1", schedule)
+			}
+			if tt.holiday != "" {
+				store.addHoliday(tt.holiday)
+			}
+			s := newTestScheduler(store, fake.NewClouds())
+
+			got, err := s.desiredState("1", tt.lastAction, now)
+			if err != nil {
+				t.Fatalf("desiredState returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("desiredState = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileStartsDriftedResources(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddInstance("i-dev", false, map[string]string{"env": "dev"})
+	cloud.AddInstance("i-prod", false, map[string]string{"env": "prod"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:      "dev",
+		Resources: []models.AWSResource{{Type: "EC2", Tags: envTag("dev")}},
+	})
+	// 매분 시작하는 스케줄이므로 원하는 상태는 start
+	store.addSchedule("1", models.Schedule{
+		ID:        1,
+		GroupID:   1,
+		StartCron: "0 * * * * *",
+		StopCron:  "0 0 0 1 1 *",
+		Timezone:  "UTC",
+		Enabled:   true,
+		CreatedAt: time.Now().Add(-48 * time.Hour),
+	})
+	s := newTestScheduler(store, clouds)
+
+	s.reconcile(context.Background())
+
+	if status := store.groupStatus("1"); status != models.GroupStatusDrifted {
+		t.Errorf("group status = %q, want %q", status, models.GroupStatusDrifted)
+	}
+	if calls := cloud.Calls(); !slices.Equal(calls, []string{"StartInstances i-dev"}) {
+		t.Errorf("calls = %v, want [StartInstances i-dev]", calls)
+	}
+	actions := store.recordedActions()
+	if len(actions) != 1 || actions[0].Action != models.ActionStart || actions[0].Trigger != models.TriggerReconcile {
+		t.Fatalf("actions = %+v, want one reconcile start", actions)
+	}
+	if statuses := store.jobStatuses(actions[0].ActionID); statuses[0].Status != models.JobStatusCompleted {
+		t.Errorf("reconcile action status = %s (%s), want completed", statuses[0].Status, statuses[0].Message)
+	}
+
+	// 되돌린 뒤에는 상태만 갱신하고 작업을 기록하지 않음
+	s.reconcile(context.Background())
+
+	if status := store.groupStatus("1"); status != models.GroupStatusRunning {
+		t.Errorf("group status after correction = %q, want %q", status, models.GroupStatusRunning)
+	}
+	if actions := store.recordedActions(); len(actions) != 1 {
+		t.Errorf("got %d actions after second reconcile, want 1", len(actions))
+	}
+	if state := cloud.InstanceState("i-prod"); state != "stopped" {
+		t.Errorf("i-prod state = %s, want stopped", state)
+	}
+}
+
+// AWS가 7일 뒤 자동으로 시작한 RDS 인스턴스는 중지 상태여야 하는 그룹에서 다시 중지됨
+func TestReconcileStopsAutoStartedRDSInstance(t *testing.T) {
+	clouds := fake.NewClouds()
+	cloud := clouds.Region("us-east-1")
+	cloud.AddDBInstance("db-1", true, map[string]string{"env": "dev"})
+
+	store := newMemoryStore()
+	store.addGroup("1", models.ResourceGroup{
+		Name:      "dev",
+		Resources: []models.AWSResource{{Type: "RDS", Tags: envTag("dev")}},
+	})
+	store.addAction(models.ActionLog{
+		ActionID:  "manual-stop",
+		GroupID:   1,
+		Action:    models.ActionStop,
+		Trigger:   models.TriggerAPI,
+		Status:    models.JobStatusCompleted,
+		CreatedAt: time.Now().Add(-7 * 24 * time.Hour),
+	})
+	s := newTestScheduler(store, clouds)
+
+	s.reconcile(context.Background())
+
+	if calls := cloud.Calls(); !slices.Equal(calls, []string{"StopDBInstance db-1"}) {
+		t.Errorf("calls = %v, want [StopDBInstance db-1]", calls)
+	}
+	actions := store.recordedActions()
+	if len(actions) != 2 {
+		t.Fatalf("got %d actions, want the manual stop and one reconcile action", len(actions))
+	}
+	if action := actions[1]; action.Action != models.ActionStop || action.Trigger != models.TriggerReconcile || action.RequestedBy != reconcileRequestedBy {
+		t.Errorf("reconcile action = %+v, want stop by the reconciler", action)
+	}
+	statuses := store.jobStatuses(actions[1].ActionID)
+	if len(statuses) != 2 || statuses[0].Status != models.JobStatusCompleted {
+		t.Fatalf("reconcile statuses = %+v, want completed action with one entry", statuses)
+	}
+	if entry := statuses[1]; entry.ResourceType != "RDS" || !slices.Equal(entry.ResourceIDs, []string{"db-1"}) {
+		t.Errorf("RDS entry status = %+v, want db-1", entry)
+	}
+	if status := store.groupStatus("1"); status != models.GroupStatusDrifted {
+		t.Errorf("group status = %q, want %q", status, models.GroupStatusDrifted)
+	}
+	if state := cloud.DBInstanceStatus("db-1"); state != "stopped" {
+		t.Errorf("db-1 status = %s, want stopped", state)
+	}
+}
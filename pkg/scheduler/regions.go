@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"strings"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// groupTarget은 그룹의 리소스를 제어할 클라이언트와 리소스 항목에 리전이 없을 때 사용할 리전 목록입니다.
+type groupTarget struct {
+	client  *aws.AWSClient
+	regions []string
+	// 저장된 용량이 없는 ECS 서비스를 시작할 때의 DesiredCount (그룹 설정)
+	ecsDefaultDesiredCount int32
+}
+
+// regionalEntry는 한 리전에서 실행할 리소스 항목입니다.
+type regionalEntry struct {
+	resource models.AWSResource
+	client   *aws.AWSClient // 실행 리전과 계정의 클라이언트
+	region   string         // 실행 리전
+	primary  bool           // 항목의 첫 번째 리전 여부 (ARN이 아닌 명시적 ID는 첫 번째 리전에서만 사용)
+
+	ecsDefaultDesiredCount int32 // 저장된 용량이 없는 ECS 서비스를 시작할 때의 DesiredCount
+}
+
+// resolveTarget은 그룹의 계정과 리전 설정으로 groupTarget을 만듭니다.
+// 계정이 지정된 그룹은 계정의 역할을 맡은 클라이언트를 사용하고, 그룹에 리전이 없으면 계정의 기본 리전을 사용합니다.
+func (s *Scheduler) resolveTarget(group models.ResourceGroup) (groupTarget, error) {
+	target := groupTarget{client: s.AWSClient, regions: group.Regions, ecsDefaultDesiredCount: group.ECSDefaultDesiredCount}
+	if group.AccountID == nil {
+		return target, nil
+	}
+
+	account, err := s.DB.GetAccount(*group.AccountID)
+	if err != nil {
+		return groupTarget{}, err
+	}
+	target.client = s.AWSClient.ForAccount(account)
+	if len(target.regions) == 0 {
+		target.regions = account.Regions
+	}
+	return target, nil
+}
+
+// entryRegions는 리소스 항목이 실행될 리전 목록을 반환합니다.
+// 항목의 리전, 그룹 (또는 계정) 의 리전, 클라이언트의 기본 리전 순으로 사용합니다.
+func entryRegions(target groupTarget, resource models.AWSResource) []string {
+	if len(resource.Regions) > 0 {
+		return resource.Regions
+	}
+	if len(target.regions) > 0 {
+		return target.regions
+	}
+	return []string{target.client.Region()}
+}
+
+// expandRegions는 단계의 리소스 항목을 리전별 실행 단위로 펼칩니다.
+func expandRegions(target groupTarget, tier []models.AWSResource) []regionalEntry {
+	var entries []regionalEntry
+	for _, resource := range tier {
+		for i, region := range entryRegions(target, resource) {
+			entries = append(entries, regionalEntry{
+				resource: resource,
+				client:   target.client.ForRegion(region),
+				region:   region,
+				primary:  i == 0,
+
+				ecsDefaultDesiredCount: target.ecsDefaultDesiredCount,
+			})
+		}
+	}
+	return entries
+}
+
+// arnRegion은 ARN의 리전을 반환합니다. ARN이 아니면 빈 문자열을 반환합니다.
+// arn:<partition>:<service>:<region>:<account>:<resource>
+func arnRegion(id string) string {
+	if !strings.HasPrefix(id, "arn:") {
+		return ""
+	}
+	parts := strings.SplitN(id, ":", 6)
+	if len(parts) < 6 {
+		return ""
+	}
+	return parts[3]
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+)
+
+// resolveResourceIDs는 리소스 항목의 태그 일치 결과에 명시적 ID를 더하고 제외 목록을 뺀 리소스 ID 목록을 반환합니다.
+// 태그가 없는 항목은 GetByTags를 호출하지 않으므로 (빈 필터는 모든 리소스와 일치함) 명시적 ID만 사용합니다.
+// ARN으로 지정한 ID는 ARN의 리전에서만, 그 외 ID는 항목의 첫 번째 리전에서만 사용하며,
+// 매니저가 ExplicitIDFilter를 구현하면 GetByTags와 같은 기준으로 걸러냅니다.
+func resolveResourceIDs(ctx context.Context, manager aws.AWSResourceManager, entry regionalEntry) ([]string, error) {
+	resource := entry.resource
+	var candidates []string
+	if len(resource.Tags) > 0 {
+		matched, err := manager.GetByTags(ctx, resource.Tags)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, matched...)
+	}
+	var explicit []string
+	for _, id := range resource.IDs {
+		if region := arnRegion(id); region != "" {
+			if region != entry.region {
+				continue
+			}
+		} else if !entry.primary {
+			continue
+		}
+		explicit = append(explicit, normalizeResourceID(resource.Type, id))
+	}
+	if filter, ok := manager.(aws.ExplicitIDFilter); ok && len(explicit) > 0 {
+		filtered, err := filter.FilterIDs(ctx, explicit)
+		if err != nil {
+			return nil, err
+		}
+		explicit = filtered
+	}
+	candidates = append(candidates, explicit...)
+
+	excluded := make(map[string]bool, len(resource.Exclude))
+	for _, id := range resource.Exclude {
+		excluded[resourceKey(resource.Type, id)] = true
+	}
+
+	seen := make(map[string]bool, len(candidates))
+	var resourceIDs []string
+	for _, id := range candidates {
+		key := resourceKey(resource.Type, id)
+		if excluded[key] || seen[key] {
+			continue
+		}
+		if name := serviceNameKey(resource.Type, id); name != "" && excluded[name] {
+			continue
+		}
+		seen[key] = true
+		resourceIDs = append(resourceIDs, id)
+	}
+	return resourceIDs, nil
+}
+
+// normalizeResourceID는 매니저가 Start/Stop에 사용하는 ID 형식으로 ARN을 변환합니다.
+// EC2 인스턴스, Auto Scaling 그룹과 RDS 인스턴스/클러스터는 ARN 대신 ID (이름, 식별자) 를 사용하며, ECS는 ARN을 그대로 사용합니다.
+func normalizeResourceID(resourceType, id string) string {
+	if !strings.HasPrefix(id, "arn:") {
+		return id
+	}
+	switch resourceType {
+	case "EC2", "ASG":
+		// arn:aws:ec2:<region>:<account>:instance/i-0123
+		// arn:aws:autoscaling:<region>:<account>:autoScalingGroup:<uuid>:autoScalingGroupName/<name>
+		return id[strings.LastIndex(id, "/")+1:]
+	case "RDS", "RDS_CLUSTER":
+		// arn:aws:rds:<region>:<account>:db:<identifier>, arn:aws:rds:<region>:<account>:cluster:<identifier>
+		return id[strings.LastIndex(id, ":")+1:]
+	default:
+		return id
+	}
+}
+
+// resourceKey는 중복 제거와 제외 목록 비교에 사용하는 키입니다.
+// ECS 클러스터는 ARN과 이름을 모두 허용하므로 클러스터 이름으로 비교합니다.
+func resourceKey(resourceType, id string) string {
+	id = normalizeResourceID(resourceType, id)
+	if resourceType == "ECS" {
+		return id[strings.LastIndex(id, "/")+1:]
+	}
+	return id
+}
+
+// serviceNameKey는 ECS_SERVICE 항목의 서비스 ARN에서 서비스 이름을 반환합니다. 다른 유형은 빈 문자열입니다.
+// 서비스 이름으로 지정한 제외 항목은 모든 클러스터의 같은 이름 서비스와 일치하며,
+// 중복 제거는 클러스터가 다른 같은 이름의 서비스를 구분하도록 ARN으로 비교합니다.
+func serviceNameKey(resourceType, id string) string {
+	if resourceType != "ECS_SERVICE" {
+		return ""
+	}
+	return id[strings.LastIndex(id, "/")+1:]
+}
@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// taggedManager는 GetByTags가 고정된 ID 목록을 반환하는 테스트용 매니저입니다.
+type taggedManager []string
+
+func (m taggedManager) Start(ctx context.Context, resourceIDs []string) error { return nil }
+
+func (m taggedManager) Stop(ctx context.Context, resourceIDs []string) error { return nil }
+
+func (m taggedManager) GetByTags(ctx context.Context, resourceTags []models.ResourceTag) ([]string, error) {
+	return m, nil
+}
+
+func TestResolveResourceIDs(t *testing.T) {
+	const (
+		apiDev     = "arn:aws:ecs:us-east-1:123456789012:service/dev/api"
+		apiStaging = "arn:aws:ecs:us-east-1:123456789012:service/staging/api"
+		workerDev  = "arn:aws:ecs:us-east-1:123456789012:service/dev/worker"
+	)
+
+	tests := []struct {
+		name     string
+		resource models.AWSResource
+		tagged   []string
+		want     []string
+	}{
+		{
+			name:     "EC2 exclusion by ARN",
+			resource: models.AWSResource{Type: "EC2", Tags: envTag("dev"), Exclude: []string{"arn:aws:ec2:us-east-1:123456789012:instance/i-2"}},
+			tagged:   []string{"i-1", "i-2"},
+			want:     []string{"i-1"},
+		},
+		{
+			name:     "explicit IDs are merged and deduplicated",
+			resource: models.AWSResource{Type: "RDS", Tags: envTag("dev"), IDs: []string{"arn:aws:rds:us-east-1:123456789012:db:db-1", "db-2"}},
+			tagged:   []string{"db-1"},
+			want:     []string{"db-1", "db-2"},
+		},
+		{
+			name:     "ECS cluster exclusion by name",
+			resource: models.AWSResource{Type: "ECS", Tags: envTag("dev"), Exclude: []string{"staging"}},
+			tagged:   []string{"arn:aws:ecs:us-east-1:123456789012:cluster/dev", "arn:aws:ecs:us-east-1:123456789012:cluster/staging"},
+			want:     []string{"arn:aws:ecs:us-east-1:123456789012:cluster/dev"},
+		},
+		{
+			name:     "ECS_SERVICE exclusion by ARN",
+			resource: models.AWSResource{Type: "ECS_SERVICE", Tags: envTag("dev"), Exclude: []string{apiStaging}},
+			tagged:   []string{apiDev, apiStaging, workerDev},
+			want:     []string{apiDev, workerDev},
+		},
+		{
+			name:     "ECS_SERVICE exclusion by service name",
+			resource: models.AWSResource{Type: "ECS_SERVICE", Tags: envTag("dev"), Exclude: []string{"api"}},
+			tagged:   []string{apiDev, apiStaging, workerDev},
+			want:     []string{workerDev},
+		},
+		{
+			name:     "ECS_SERVICE services with the same name in different clusters are kept",
+			resource: models.AWSResource{Type: "ECS_SERVICE", Tags: envTag("dev"), IDs: []string{apiDev}},
+			tagged:   []string{apiDev, apiStaging},
+			want:     []string{apiDev, apiStaging},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := regionalEntry{resource: tt.resource, region: "us-east-1", primary: true}
+			got, err := resolveResourceIDs(context.Background(), taggedManager(tt.tagged), entry)
+			if err != nil {
+				t.Fatalf("resolveResourceIDs returned error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolveResourceIDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,86 +5,284 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
-	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 )
 
+// cronParser는 초 단위 필드를 포함한 cron 표현식 파서입니다 (cron.WithSeconds와 동일).
+var cronParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Store는 스케줄러가 사용하는 데이터베이스 메서드입니다. *database.DB가 구현하며 테스트에서는 메모리 저장소로 대체할 수 있습니다.
+type Store interface {
+	GetAllGroups() ([]map[string]interface{}, error)
+	GetGroupByID(groupID string) (map[string]interface{}, error)
+	UpdateGroupStatus(groupID, status string) error
+	GetAccount(accountID int) (models.AWSAccount, error)
+	GetEnabledSchedules() ([]models.Schedule, error)
+	GetSchedulesByGroup(groupID string) ([]models.Schedule, error)
+	IsHoliday(calendarID int, day time.Time) (bool, error)
+	RecordAction(actionID, groupID, actionType string, origin models.ActionOrigin) error
+	GetLatestGroupActions() ([]models.ActionLog, error)
+	CreateJobStatus(actionID, resourceType, region, status, message string) (int, error)
+	UpdateJobStatus(id int, status, message string, resourceIDs []string) error
+}
+
 // Scheduler는 작업을 관리하고 AWS 리소스를 제어하기 위한 구조체입니다.
 type Scheduler struct {
-	cron       *cron.Cron              // cron 스케줄러 인스턴스
-	jobMutex   sync.Mutex              // 작업 등록/삭제 보호를 위한 Mutex
-	jobEntries map[string]cron.EntryID // 작업 ID를 저장하는 맵
-	AWSClient  *aws.AWSClient          // AWS 리소스 매니저 클라이언트
-	DB         *database.DB            // 데이터베이스 클라이언트
-	Context    context.Context         // 작업 실행 시 사용할 기본 Context
+	cron       *cron.Cron           // cron 스케줄러 인스턴스
+	jobMutex   sync.Mutex           // 작업 등록/삭제 보호를 위한 Mutex
+	jobEntries map[int]scheduleJobs // 스케줄 ID별로 등록된 cron 작업을 저장하는 맵
+	AWSClient  *aws.AWSClient       // AWS 리소스 매니저 클라이언트
+	DB         Store                // 데이터베이스 클라이언트
+	Context    context.Context      // 작업 실행 시 사용할 기본 Context
+
+	WaitTimeout  time.Duration // 시작/중지 후 리소스가 목표 상태에 도달할 때까지 기다리는 최대 시간 (0이면 기다리지 않음)
+	WaitInterval time.Duration // 목표 상태 대기 중 상태 조회 간격
+
+	ReconcileInterval time.Duration      // 그룹의 리소스가 원하는 상태와 일치하는지 확인하는 주기 (기본값 0, 0이면 확인하지 않음)
+	stopReconciler    context.CancelFunc // 리컨사일러 종료 함수
+}
+
+const (
+	// DefaultWaitTimeout은 리소스가 목표 상태에 도달할 때까지 기다리는 기본 최대 시간입니다.
+	DefaultWaitTimeout = 15 * time.Minute
+	// DefaultWaitInterval은 목표 상태 대기 중 상태 조회 기본 간격입니다.
+	DefaultWaitInterval = 15 * time.Second
+)
+
+// scheduleJobs는 하나의 스케줄에 대해 등록된 시작/중지 cron 작업을 나타냅니다.
+type scheduleJobs struct {
+	groupID int          // 스케줄이 적용되는 리소스 그룹 ID
+	start   cron.EntryID // 시작 작업의 cron 엔트리 ID
+	stop    cron.EntryID // 중지 작업의 cron 엔트리 ID
 }
 
 // NewScheduler는 새로운 Scheduler 인스턴스를 생성합니다.
-func NewScheduler(db *database.DB, awsClient *aws.AWSClient) *Scheduler {
+func NewScheduler(db Store, awsClient *aws.AWSClient) *Scheduler {
 	return &Scheduler{
-		cron:       cron.New(cron.WithSeconds()), // 초 단위 스케줄링을 지원
-		jobEntries: make(map[string]cron.EntryID),
+		cron:       cron.New(cron.WithParser(cronParser)), // 초 단위 스케줄링을 지원
+		jobEntries: make(map[int]scheduleJobs),
 		AWSClient:  awsClient,
 		DB:         db,
 		Context:    context.TODO(), // 기본 컨텍스트 생성
+
+		WaitTimeout:  DefaultWaitTimeout,
+		WaitInterval: DefaultWaitInterval,
 	}
 }
 
-// Start는 스케줄러를 시작하여 등록된 작업을 실행합니다.
+// Start는 데이터베이스에 저장된 스케줄을 복원한 뒤 스케줄러를 시작하여 등록된 작업을 실행합니다.
 func (s *Scheduler) Start() {
 	log.Println("[Scheduler] Starting scheduler...")
+	if err := s.loadSchedules(); err != nil {
+		log.Printf("[Scheduler] Failed to restore schedules: %v", err)
+	}
 	s.cron.Start()
+
+	if s.ReconcileInterval > 0 {
+		ctx, cancel := context.WithCancel(s.Context)
+		s.stopReconciler = cancel
+		go s.runReconciler(ctx)
+	}
 }
 
 // Stop은 스케줄러를 중지하여 모든 작업 실행을 멈춥니다.
 func (s *Scheduler) Stop() {
 	log.Println("[Scheduler] Stopping scheduler...")
 	s.cron.Stop()
+	if s.stopReconciler != nil {
+		s.stopReconciler()
+	}
 }
 
-// ScheduleGroup은 특정 리소스 그룹에 대한 시작 및 중지 작업을 스케줄에 등록합니다.
-// 그룹 ID, 시작 시간, 중지 시간을 입력받아 작업을 등록합니다.
-func (s *Scheduler) ScheduleGroup(groupID, startTime, stopTime string) error {
-	// 시작 작업 등록
-	startJobID, err := s.addJob(startTime, func() {
-		log.Printf("[Scheduler] Automatically starting group %s", groupID)
-		_, err := s.StartGroup(groupID)
+// ScheduleGroup은 스케줄에 정의된 리소스 그룹의 시작 및 중지 작업을 cron에 등록합니다.
+// 같은 스케줄 ID로 이미 등록된 작업이 있으면 교체하므로 중복 등록되지 않으며,
+// cron 표현식을 먼저 해석하므로 등록에 실패하면 기존 작업이 그대로 유지됩니다.
+func (s *Scheduler) ScheduleGroup(schedule models.Schedule) error {
+	startSchedule, err := cronParser.Parse(cronSpec(schedule.StartCron, schedule.Timezone))
+	if err != nil {
+		return fmt.Errorf("invalid start schedule: %w", err)
+	}
+	stopSchedule, err := cronParser.Parse(cronSpec(schedule.StopCron, schedule.Timezone))
+	if err != nil {
+		return fmt.Errorf("invalid stop schedule: %w", err)
+	}
+
+	groupID := fmt.Sprintf("%d", schedule.GroupID)
+	origin := models.ActionOrigin{
+		Trigger:     models.TriggerCron,
+		RequestedBy: fmt.Sprintf("schedule:%d", schedule.ID),
+	}
+
+	// 시작 작업 생성 (휴일 캘린더에 포함된 날짜에는 건너뜀)
+	startJob := func() {
+		if s.isHoliday(schedule, time.Now()) {
+			log.Printf("[Scheduler] Skipping start of group %s (schedule %d): holiday in calendar %d", groupID, schedule.ID, *schedule.CalendarID)
+			return
+		}
+		log.Printf("[Scheduler] Automatically starting group %s (schedule %d)", groupID, schedule.ID)
+		_, err := s.StartGroup(groupID, origin)
 		if err != nil {
 			log.Printf("[Scheduler] Failed to start group %s: %v", groupID, err)
 		}
-	})
-	if err != nil {
-		return err
 	}
 
-	// 중지 작업 등록
-	stopJobID, err := s.addJob(stopTime, func() {
-		log.Printf("[Scheduler] Automatically stopping group %s", groupID)
-		_, err := s.StopGroup(groupID)
+	// 중지 작업 생성
+	stopJob := func() {
+		log.Printf("[Scheduler] Automatically stopping group %s (schedule %d)", groupID, schedule.ID)
+		_, err := s.StopGroup(groupID, origin)
 		if err != nil {
 			log.Printf("[Scheduler] Failed to stop group %s: %v", groupID, err)
 		}
-	})
-	if err != nil {
+	}
+
+	s.jobMutex.Lock()
+	defer s.jobMutex.Unlock()
+
+	s.removeJobsLocked(schedule.ID)
+	startEntryID := s.cron.Schedule(startSchedule, cron.FuncJob(startJob))
+	stopEntryID := s.cron.Schedule(stopSchedule, cron.FuncJob(stopJob))
+
+	s.jobEntries[schedule.ID] = scheduleJobs{
+		groupID: schedule.GroupID,
+		start:   startEntryID,
+		stop:    stopEntryID,
+	}
+
+	log.Printf("[Scheduler] Scheduled group %s (Start: %s, Stop: %s, Timezone: %q) with schedule %d, entry IDs (%d, %d)",
+		groupID, schedule.StartCron, schedule.StopCron, schedule.Timezone, schedule.ID, startEntryID, stopEntryID)
+	return nil
+}
+
+// ValidateSchedule은 스케줄의 타임존과 시작/중지 cron 표현식이 등록 가능한 형식인지 확인합니다.
+func ValidateSchedule(schedule models.Schedule) error {
+	if err := ValidateTimezone(schedule.Timezone); err != nil {
 		return err
 	}
+	if _, err := cronParser.Parse(cronSpec(schedule.StartCron, schedule.Timezone)); err != nil {
+		return fmt.Errorf("invalid start schedule: %w", err)
+	}
+	if _, err := cronParser.Parse(cronSpec(schedule.StopCron, schedule.Timezone)); err != nil {
+		return fmt.Errorf("invalid stop schedule: %w", err)
+	}
+	return nil
+}
 
-	log.Printf("[Scheduler] Scheduled group %s (Start: %s, Stop: %s) with job IDs (%d, %d)", groupID, startTime, stopTime, startJobID, stopJobID)
+// ValidateTimezone은 IANA 타임존 이름 (예: Asia/Seoul) 이 유효한지 확인합니다.
+// 빈 값은 서버 로컬 시간을 의미하므로 허용됩니다.
+func ValidateTimezone(timezone string) error {
+	if timezone == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
 	return nil
 }
 
-// addJob은 스케줄러에 특정 시간에 실행할 작업을 등록합니다.
-func (s *Scheduler) addJob(schedule string, task func()) (cron.EntryID, error) {
+// cronSpec은 타임존이 지정된 경우 cron 표현식 앞에 CRON_TZ= 접두사를 붙여
+// 서버가 실행되는 위치와 관계없이 해당 타임존 기준으로 작업이 실행되도록 합니다.
+func cronSpec(expression, timezone string) string {
+	if timezone == "" {
+		return expression
+	}
+	return "CRON_TZ=" + timezone + " " + expression
+}
+
+// UnscheduleGroup은 스케줄 ID에 등록된 시작 및 중지 작업을 cron에서 제거합니다.
+func (s *Scheduler) UnscheduleGroup(scheduleID int) {
+	s.jobMutex.Lock()
+	defer s.jobMutex.Unlock()
+
+	if s.removeJobsLocked(scheduleID) {
+		log.Printf("[Scheduler] Unscheduled schedule %d", scheduleID)
+	}
+}
+
+// UnscheduleAllForGroup은 특정 리소스 그룹에 등록된 모든 스케줄 작업을 제거합니다.
+func (s *Scheduler) UnscheduleAllForGroup(groupID int) {
 	s.jobMutex.Lock()
 	defer s.jobMutex.Unlock()
 
-	entryID, err := s.cron.AddFunc(schedule, task)
+	for scheduleID, jobs := range s.jobEntries {
+		if jobs.groupID == groupID {
+			s.removeJobsLocked(scheduleID)
+		}
+	}
+}
+
+// NextRuns는 스케줄 ID에 등록된 시작 및 중지 작업의 다음 실행 시각을 반환합니다.
+// 등록된 작업이 없으면 (일시 중지된 스케줄 등) ok는 false입니다.
+func (s *Scheduler) NextRuns(scheduleID int) (nextStart, nextStop time.Time, ok bool) {
+	s.jobMutex.Lock()
+	jobs, exists := s.jobEntries[scheduleID]
+	s.jobMutex.Unlock()
+	if !exists {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return s.cron.Entry(jobs.start).Next, s.cron.Entry(jobs.stop).Next, true
+}
+
+// isHoliday는 스케줄의 타임존 기준 날짜가 스케줄에 연결된 휴일 캘린더에 포함되는지 확인합니다.
+// 캘린더 조회에 실패하면 평소대로 작업이 실행되도록 false를 반환합니다.
+func (s *Scheduler) isHoliday(schedule models.Schedule, now time.Time) bool {
+	if schedule.CalendarID == nil {
+		return false
+	}
+
+	location := time.Local
+	if schedule.Timezone != "" {
+		if loc, err := time.LoadLocation(schedule.Timezone); err == nil {
+			location = loc
+		}
+	}
+
+	holiday, err := s.DB.IsHoliday(*schedule.CalendarID, now.In(location))
 	if err != nil {
-		return 0, err
+		log.Printf("[Scheduler] Failed to check holiday calendar %d for schedule %d: %v", *schedule.CalendarID, schedule.ID, err)
+		return false
+	}
+	return holiday
+}
+
+// loadSchedules는 데이터베이스에 저장된 활성 스케줄을 cron 스케줄러에 다시 등록합니다.
+// 서버 재시작 후에도 기존 스케줄이 유지되도록 Start에서 호출됩니다.
+func (s *Scheduler) loadSchedules() error {
+	schedules, err := s.DB.GetEnabledSchedules()
+	if err != nil {
+		return err
+	}
+
+	restored, failed := 0, 0
+	for _, schedule := range schedules {
+		if err := s.ScheduleGroup(schedule); err != nil {
+			log.Printf("[Scheduler] Failed to restore schedule %d for group %d: %v", schedule.ID, schedule.GroupID, err)
+			failed++
+			continue
+		}
+		restored++
+	}
+
+	log.Printf("[Scheduler] Restored %d schedule(s) from database", restored)
+	if failed > 0 {
+		log.Printf("[Scheduler] Failed to restore %d schedule(s) from database", failed)
+	}
+	return nil
+}
+
+// removeJobsLocked는 스케줄 ID에 등록된 cron 작업을 제거합니다. jobMutex를 잡은 상태에서 호출해야 합니다.
+func (s *Scheduler) removeJobsLocked(scheduleID int) bool {
+	jobs, exists := s.jobEntries[scheduleID]
+	if !exists {
+		return false
 	}
 
-	s.jobEntries[fmt.Sprintf("%d", entryID)] = entryID
-	return entryID, nil
+	s.cron.Remove(jobs.start)
+	s.cron.Remove(jobs.stop)
+	delete(s.jobEntries, scheduleID)
+	return true
 }
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws/fake"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+func TestScheduleGroupKeepsJobsOnInvalidSchedule(t *testing.T) {
+	s := newTestScheduler(newMemoryStore(), fake.NewClouds())
+	schedule := models.Schedule{ID: 1, GroupID: 1, StartCron: "0 0 9 * * *", StopCron: "0 0 18 * * *", Timezone: "UTC", Enabled: true}
+	if err := s.ScheduleGroup(schedule); err != nil {
+		t.Fatalf("ScheduleGroup returned error: %v", err)
+	}
+	s.cron.Start()
+	defer s.cron.Stop()
+	nextStart, nextStop, _ := s.NextRuns(1)
+
+	invalid := schedule
+	invalid.StopCron = "0 0 25 * * *"
+	if err := s.ScheduleGroup(invalid); err == nil {
+		t.Fatal("ScheduleGroup with an invalid stop time returned no error")
+	}
+
+	// 등록에 실패해도 기존 작업은 그대로 유지
+	start, stop, ok := s.NextRuns(1)
+	if !ok || !start.Equal(nextStart) || !stop.Equal(nextStop) {
+		t.Errorf("NextRuns = %v, %v, %v, want the previous jobs %v, %v", start, stop, ok, nextStart, nextStop)
+	}
+	if entries := len(s.cron.Entries()); entries != 2 {
+		t.Errorf("got %d cron entries, want 2", entries)
+	}
+}
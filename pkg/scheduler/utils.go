@@ -4,26 +4,25 @@ import (
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 )
 
-// extractResources는 그룹 데이터에서 AWS 리소스 정보를 추출합니다.
-func extractResources(groupData map[string]interface{}) []models.AWSResource {
-	var resources []models.AWSResource
+// extractGroup은 그룹 데이터에서 그룹 이름, 설정, 리소스 목록을 추출합니다.
+func extractGroup(groupData map[string]interface{}) models.ResourceGroup {
+	name, _ := groupData["name"].(string)
+	ecsDefault, _ := groupData["ecs_default_desired_count"].(int32)
+	regions, _ := groupData["regions"].([]string)
+	accountID, _ := groupData["account_id"].(*int)
 
-	rawResources, ok := groupData["resources"].([]map[string]interface{})
-	if !ok {
-		return resources
+	return models.ResourceGroup{
+		Name:                   name,
+		Resources:              extractResources(groupData),
+		ECSDefaultDesiredCount: ecsDefault,
+		Regions:                regions,
+		AccountID:              accountID,
 	}
+}
 
-	for _, r := range rawResources {
-		rType, _ := r["resource_type"].(string)
-		tagKey, _ := r["tag_key"].(string)
-		tagValue, _ := r["tag_value"].(string)
-
-		resources = append(resources, models.AWSResource{
-			Type: rType,
-			Tags: []models.ResourceTag{
-				{Key: tagKey, Value: tagValue},
-			},
-		})
-	}
+// extractResources는 그룹 데이터에서 AWS 리소스 정보를 추출합니다.
+// 각 리소스 항목의 태그 목록은 저장된 그대로 하나의 선택자로 전달되며, 매니저는 모든 태그가 일치하는 리소스만 선택합니다.
+func extractResources(groupData map[string]interface{}) []models.AWSResource {
+	resources, _ := groupData["resources"].([]models.AWSResource)
 	return resources
 }
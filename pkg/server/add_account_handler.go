@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+type AddAccountRequest struct {
+	Name       string   `json:"name"`
+	AccountID  string   `json:"aws_account_id"`        // 12자리 AWS 계정 ID
+	RoleARN    string   `json:"role_arn"`              // 맡을 IAM 역할 ARN
+	ExternalID string   `json:"external_id,omitempty"` // 역할의 신뢰 정책이 요구하는 외부 ID
+	Regions    []string `json:"regions,omitempty"`     // 그룹에 리전이 없을 때 사용할 기본 리전
+}
+
+// AddAccountHandler는 리소스 그룹이 STS AssumeRole로 사용할 AWS 계정을 등록하는 핸들러입니다.
+func AddAccountHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddAccountRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Printf("Error decoding request body: %v", err)
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		if req.Name == "" {
+			http.Error(w, "Account name is required", http.StatusBadRequest)
+			return
+		}
+
+		account := models.AWSAccount{
+			Name:       req.Name,
+			AccountID:  req.AccountID,
+			RoleARN:    req.RoleARN,
+			ExternalID: req.ExternalID,
+			Regions:    req.Regions,
+		}
+		if err := aws.ValidateAccount(account); err != nil {
+			log.Printf("Invalid account: %v", err)
+			http.Error(w, "Invalid account: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := db.AddAccount(&account); err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to create account", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(account); err != nil {
+			log.Printf("Error encoding response: %v", err)
+			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/calendar"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+// CalendarRequest는 JSON 형식의 휴일 캘린더 업로드 요청입니다.
+type CalendarRequest struct {
+	Name     string           `json:"name"`
+	Dates    []string         `json:"dates"`    // 날짜 목록 (YYYY-MM-DD)
+	Holidays []models.Holiday `json:"holidays"` // 이름이 있는 휴일 목록
+}
+
+type AddCalendarResponse struct {
+	ID      int    `json:"id"`
+	Message string `json:"message"`
+}
+
+// AddCalendarHandler는 휴일 캘린더를 생성하는 핸들러입니다.
+// JSON 날짜 목록 또는 Content-Type: text/calendar 인 ICS 파일 (이름은 ?name= 쿼리) 을 받습니다.
+func AddCalendarHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name, holidays, err := parseCalendarUpload(r)
+		if err != nil {
+			log.Printf("Invalid calendar upload: %v", err)
+			http.Error(w, "Invalid calendar: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if name == "" {
+			http.Error(w, "Calendar name is required", http.StatusBadRequest)
+			return
+		}
+
+		calendarID, err := db.AddCalendar(name, holidays)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to create calendar", http.StatusInternalServerError)
+			return
+		}
+
+		response := AddCalendarResponse{
+			ID:      calendarID,
+			Message: fmt.Sprintf("Calendar created successfully with %d holiday(s)", len(holidays)),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			log.Printf("Error encoding response: %v", err)
+			http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		}
+	}
+}
+
+// parseCalendarUpload는 요청 본문을 Content-Type에 따라 ICS 또는 JSON으로 해석하여
+// 캘린더 이름과 정렬 및 중복 제거된 휴일 목록을 반환합니다.
+func parseCalendarUpload(r *http.Request) (string, []models.Holiday, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/calendar" {
+		holidays, err := calendar.ParseICS(r.Body)
+		if err != nil {
+			return "", nil, err
+		}
+		return r.URL.Query().Get("name"), holidays, nil
+	}
+
+	var req CalendarRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return "", nil, fmt.Errorf("invalid request payload")
+	}
+
+	holidays := req.Holidays
+	for _, date := range req.Dates {
+		holidays = append(holidays, models.Holiday{Date: date})
+	}
+
+	holidays, err := calendar.NormalizeHolidays(holidays)
+	if err != nil {
+		return "", nil, err
+	}
+	return req.Name, holidays, nil
+}
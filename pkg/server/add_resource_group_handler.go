@@ -5,14 +5,19 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
 )
 
 type AddResourceGroupRequest struct {
-	Name      string               `json:"name"`
-	Status    string               `json:"status"`
-	Resources []models.AWSResource `json:"resources"` // AWS 리소스 타입 참조
+	Name                   string               `json:"name"`
+	Status                 string               `json:"status"`
+	Resources              []models.AWSResource `json:"resources"`                           // AWS 리소스 타입 참조
+	ECSDefaultDesiredCount *int32               `json:"ecs_default_desired_count,omitempty"` // 저장된 용량이 없는 ECS 서비스의 시작 시 DesiredCount (기본값 1)
+	Regions                []string             `json:"regions,omitempty"`                   // 리소스 항목의 기본 리전 목록 (비어 있으면 계정 또는 서버의 기본 리전)
+	AccountID              *int                 `json:"account_id,omitempty"`                // 리소스가 있는 AWS 계정 ID (비어 있으면 서버의 자격 증명 사용)
 }
 
 type AddResourceGroupResponse struct {
@@ -39,7 +44,67 @@ func AddResourceGroupHandler(db *database.DB) http.HandlerFunc {
 			return
 		}
 
-		groupID, err := db.AddResourceGroup(req.Name, req.Status, req.Resources)
+		if err := aws.ValidateRegions(req.Regions); err != nil {
+			http.Error(w, "Invalid regions: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.AccountID != nil {
+			exists, err := db.AccountExists(*req.AccountID)
+			if err != nil {
+				log.Printf("Database error: %v", err)
+				http.Error(w, "Failed to check account", http.StatusInternalServerError)
+				return
+			}
+			if !exists {
+				http.Error(w, "Account not found", http.StatusBadRequest)
+				return
+			}
+		}
+
+		for _, resource := range req.Resources {
+			if err := aws.ValidateResourceType(resource.Type); err != nil {
+				http.Error(w, "Invalid resource type: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			// 태그도 ID도 없는 항목은 모든 리소스와 일치하게 되므로 허용하지 않음
+			if len(resource.Tags) == 0 && len(resource.IDs) == 0 {
+				http.Error(w, "Each resource entry requires tags or ids", http.StatusBadRequest)
+				return
+			}
+			if err := aws.ValidateTagSelectors(resource.Tags); err != nil {
+				log.Printf("Invalid tag selector: %v", err)
+				http.Error(w, "Invalid tag selector: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err := aws.ValidateRegions(resource.Regions); err != nil {
+				http.Error(w, "Invalid regions: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if err := scheduler.ValidateResourceOrder(req.Resources); err != nil {
+			log.Printf("Invalid resource order: %v", err)
+			http.Error(w, "Invalid resource order: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		group := models.ResourceGroup{
+			Name:                   req.Name,
+			Resources:              req.Resources,
+			ECSDefaultDesiredCount: aws.DefaultECSDesiredCount,
+			Regions:                req.Regions,
+			AccountID:              req.AccountID,
+		}
+		if req.ECSDefaultDesiredCount != nil {
+			if *req.ECSDefaultDesiredCount < 1 {
+				http.Error(w, "ecs_default_desired_count must be at least 1", http.StatusBadRequest)
+				return
+			}
+			group.ECSDefaultDesiredCount = *req.ECSDefaultDesiredCount
+		}
+
+		groupID, err := db.AddResourceGroup(group, req.Status)
 		if err != nil {
 			log.Printf("Database error: %v", err)
 			http.Error(w, "Failed to create resource group", http.StatusInternalServerError)
@@ -66,6 +66,7 @@ func LoginHandler(w http.ResponseWriter, r *http.Request) {
 
 	// JWT 생성
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":      loginRequest.Username, // 작업 기록의 요청자로 사용
 		"username": loginRequest.Username,
 		"exp":      time.Now().Add(time.Hour * 24).Unix(), // 24시간 후 만료
 	})
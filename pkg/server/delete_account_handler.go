@@ -0,0 +1,42 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+)
+
+// DeleteAccountHandler는 AWS 계정을 삭제하는 핸들러입니다.
+// 리소스 그룹이 사용 중인 계정은 삭제하지 않고 409를 반환합니다.
+func DeleteAccountHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		accountID, ok := accountIDFromRequest(w, r)
+		if !ok {
+			return
+		}
+
+		err := db.DeleteAccount(accountID)
+		if errors.Is(err, database.ErrAccountNotFound) {
+			http.Error(w, "Account not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, database.ErrAccountInUse) {
+			http.Error(w, "Account is used by a resource group", http.StatusConflict)
+			return
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to delete account", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"status":  "success",
+			"message": "Account deleted successfully",
+		})
+	}
+}
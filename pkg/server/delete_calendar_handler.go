@@ -0,0 +1,38 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+)
+
+// DeleteCalendarHandler는 휴일 캘린더를 삭제하는 핸들러입니다.
+// 캘린더를 사용하던 스케줄은 삭제되지 않고 휴일 확인 없이 실행됩니다.
+func DeleteCalendarHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calendarID, ok := calendarIDFromRequest(w, r)
+		if !ok {
+			return
+		}
+
+		err := db.DeleteCalendar(calendarID)
+		if errors.Is(err, database.ErrCalendarNotFound) {
+			http.Error(w, "Calendar not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to delete calendar", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"status":  "success",
+			"message": "Calendar deleted successfully",
+		})
+	}
+}
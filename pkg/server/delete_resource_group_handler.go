@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
 )
 
 type DeleteResourceGroupResponse struct {
@@ -14,7 +16,7 @@ type DeleteResourceGroupResponse struct {
 }
 
 // DeleteResourceGroupHandler는 특정 리소스 그룹을 삭제하는 핸들러입니다.
-func DeleteResourceGroupHandler(db *database.DB) http.HandlerFunc {
+func DeleteResourceGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// 요청 메서드 확인
 		if r.Method != http.MethodDelete {
@@ -38,6 +40,11 @@ func DeleteResourceGroupHandler(db *database.DB) http.HandlerFunc {
 			return
 		}
 
+		// 그룹 삭제 시 스케줄 행은 CASCADE로 삭제되므로 등록된 cron 작업도 함께 제거
+		if gid, err := strconv.Atoi(groupID); err == nil {
+			scheduler.UnscheduleAllForGroup(gid)
+		}
+
 		// 응답 생성
 		response := DeleteResourceGroupResponse{
 			Message: "Resource group deleted successfully",
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
+)
+
+// DeleteScheduleHandler는 스케줄을 cron과 데이터베이스에서 모두 삭제하는 핸들러입니다.
+func DeleteScheduleHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schedule, ok := scheduleFromRequest(w, r, db)
+		if !ok {
+			return
+		}
+
+		if err := db.DeleteSchedule(schedule.ID); err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to delete schedule", http.StatusInternalServerError)
+			return
+		}
+		scheduler.UnscheduleGroup(schedule.ID)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"status":  "success",
+			"message": "Schedule deleted successfully",
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+)
+
+// GetAccountsHandler는 등록된 모든 AWS 계정 목록을 조회하는 핸들러입니다.
+func GetAccountsHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		accounts, err := db.GetAccounts()
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get accounts", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(accounts)
+	}
+}
+
+// GetAccountHandler는 특정 AWS 계정을 조회하는 핸들러입니다.
+func GetAccountHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		accountID, ok := accountIDFromRequest(w, r)
+		if !ok {
+			return
+		}
+
+		account, err := db.GetAccount(accountID)
+		if errors.Is(err, database.ErrAccountNotFound) {
+			http.Error(w, "Account not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get account", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(account)
+	}
+}
+
+// accountIDFromRequest는 URL 경로의 account_id를 정수로 변환합니다.
+// 실패한 경우 에러 응답을 작성하고 ok로 false를 반환합니다.
+func accountIDFromRequest(w http.ResponseWriter, r *http.Request) (int, bool) {
+	accountID, err := strconv.Atoi(mux.Vars(r)["account_id"])
+	if err != nil {
+		http.Error(w, "Invalid account_id", http.StatusBadRequest)
+		return 0, false
+	}
+	return accountID, true
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+)
+
+// GetCalendarsHandler는 모든 휴일 캘린더 목록을 조회하는 핸들러입니다.
+func GetCalendarsHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calendars, err := db.GetCalendars()
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get calendars", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(calendars)
+	}
+}
+
+// GetCalendarHandler는 휴일 목록을 포함한 특정 휴일 캘린더를 조회하는 핸들러입니다.
+func GetCalendarHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calendarID, ok := calendarIDFromRequest(w, r)
+		if !ok {
+			return
+		}
+
+		calendar, err := db.GetCalendar(calendarID)
+		if errors.Is(err, database.ErrCalendarNotFound) {
+			http.Error(w, "Calendar not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get calendar", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(calendar)
+	}
+}
+
+// calendarIDFromRequest는 URL 경로의 calendar_id를 정수로 변환합니다.
+// 실패한 경우 에러 응답을 작성하고 ok로 false를 반환합니다.
+func calendarIDFromRequest(w http.ResponseWriter, r *http.Request) (int, bool) {
+	calendarID, err := strconv.Atoi(mux.Vars(r)["calendar_id"])
+	if err != nil {
+		http.Error(w, "Invalid calendar_id", http.StatusBadRequest)
+		return 0, false
+	}
+	return calendarID, true
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+)
+
+const (
+	defaultActionsLimit = 20
+	maxActionsLimit     = 100
+)
+
+type GetGroupActionsResponse struct {
+	GroupID string             `json:"group_id"`
+	Actions []models.ActionLog `json:"actions"`
+	Total   int                `json:"total"`
+	Limit   int                `json:"limit"`
+	Offset  int                `json:"offset"`
+}
+
+// GetGroupActionsHandler는 리소스 그룹의 작업 기록 (수동, 스케줄 모두 포함) 을 최신순으로 페이지 단위 조회하는 핸들러입니다.
+// limit (기본 20, 최대 100) 과 offset 쿼리 파라미터를 지원합니다.
+func GetGroupActionsHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		groupID := vars["group_id"]
+
+		limit, err := queryInt(r, "limit", defaultActionsLimit)
+		if err != nil || limit < 1 || limit > maxActionsLimit {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+
+		offset, err := queryInt(r, "offset", 0)
+		if err != nil || offset < 0 {
+			http.Error(w, "Invalid offset", http.StatusBadRequest)
+			return
+		}
+
+		exists, err := db.GroupExists(groupID)
+		if err != nil || !exists {
+			log.Printf("Group %s not found: %v", groupID, err)
+			http.Error(w, "Group not found", http.StatusNotFound)
+			return
+		}
+
+		actions, total, err := db.GetGroupActions(groupID, limit, offset)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get actions", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GetGroupActionsResponse{
+			GroupID: groupID,
+			Actions: actions,
+			Total:   total,
+			Limit:   limit,
+			Offset:  offset,
+		})
+	}
+}
+
+// queryInt는 쿼리 파라미터를 정수로 변환하고, 값이 없으면 기본값을 반환합니다.
+func queryInt(r *http.Request, name string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}
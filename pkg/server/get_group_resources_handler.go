@@ -0,0 +1,59 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
+)
+
+// GroupResourcesResponse는 그룹의 리소스 항목별 대상 리소스 미리보기 응답입니다.
+type GroupResourcesResponse struct {
+	GroupID   string                   `json:"group_id"`
+	Action    string                   `json:"action,omitempty"` // dry run인 경우 미리 본 작업 (start, stop)
+	Resources []models.ResourcePreview `json:"resources"`
+}
+
+// GetGroupResourcesHandler는 그룹의 리소스 항목이 가리키는 리소스 ID, 이름, 현재 상태를 시작 순서대로 반환합니다.
+// Start/Stop은 호출하지 않으므로 스케줄을 켜기 전에 대상 리소스를 확인하는 데 사용합니다.
+func GetGroupResourcesHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID := mux.Vars(r)["group_id"]
+		previewGroupAction(w, scheduler, db, groupID, "")
+	}
+}
+
+// previewGroupAction은 그룹의 작업 대상 리소스를 조회하여 응답합니다. action이 비어 있으면 시작 순서로 반환합니다.
+func previewGroupAction(w http.ResponseWriter, scheduler *scheduler.Scheduler, db *database.DB, groupID, action string) {
+	exists, err := db.GroupExists(groupID)
+	if err != nil || !exists {
+		log.Printf("Group %s not found: %v", groupID, err)
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	resources, err := scheduler.PreviewGroup(groupID, action)
+	if err != nil {
+		log.Printf("Failed to preview resources for group %s: %v", groupID, err)
+		http.Error(w, "Failed to resolve group resources", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(GroupResourcesResponse{
+		GroupID:   groupID,
+		Action:    action,
+		Resources: resources,
+	})
+}
+
+// isDryRun은 dry_run 쿼리 파라미터가 true인지 확인합니다.
+func isDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	return dryRun
+}
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/aws"
+)
+
+// GetResourceTypesHandler는 리소스 그룹에서 사용할 수 있는 리소스 유형 목록을 조회하는 핸들러입니다.
+func GetResourceTypesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(aws.ResourceTypes())
+	}
+}
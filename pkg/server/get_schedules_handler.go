@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
+)
+
+// ScheduleResponse는 스케줄 정보와 cron에 등록된 다음 실행 시각을 함께 반환합니다.
+type ScheduleResponse struct {
+	models.Schedule
+	NextStartAt *time.Time `json:"next_start_at"` // 다음 시작 예정 시각 (일시 중지 시 null)
+	NextStopAt  *time.Time `json:"next_stop_at"`  // 다음 중지 예정 시각 (일시 중지 시 null)
+}
+
+// GetSchedulesHandler는 리소스 그룹에 등록된 모든 스케줄을 조회하는 핸들러입니다.
+func GetSchedulesHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		groupID := vars["group_id"]
+
+		schedules, err := db.GetSchedulesByGroup(groupID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get schedules", http.StatusInternalServerError)
+			return
+		}
+
+		response := make([]ScheduleResponse, 0, len(schedules))
+		for _, schedule := range schedules {
+			response = append(response, newScheduleResponse(scheduler, schedule))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+// GetScheduleHandler는 특정 스케줄을 조회하는 핸들러입니다.
+func GetScheduleHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schedule, ok := scheduleFromRequest(w, r, db)
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(newScheduleResponse(scheduler, schedule))
+	}
+}
+
+// newScheduleResponse는 스케줄에 cron 엔트리의 다음 실행 시각을 채워 응답을 만듭니다.
+func newScheduleResponse(scheduler *scheduler.Scheduler, schedule models.Schedule) ScheduleResponse {
+	response := ScheduleResponse{Schedule: schedule}
+	if nextStart, nextStop, ok := scheduler.NextRuns(schedule.ID); ok {
+		if !nextStart.IsZero() {
+			response.NextStartAt = &nextStart
+		}
+		if !nextStop.IsZero() {
+			response.NextStopAt = &nextStop
+		}
+	}
+	return response
+}
+
+// scheduleFromRequest는 URL 경로의 group_id와 schedule_id로 스케줄을 조회합니다.
+// 실패한 경우 에러 응답을 작성하고 ok로 false를 반환합니다.
+func scheduleFromRequest(w http.ResponseWriter, r *http.Request, db *database.DB) (models.Schedule, bool) {
+	vars := mux.Vars(r)
+	groupID := vars["group_id"]
+
+	scheduleID, err := strconv.Atoi(vars["schedule_id"])
+	if err != nil {
+		http.Error(w, "Invalid schedule_id", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	schedule, err := db.GetSchedule(groupID, scheduleID)
+	if errors.Is(err, database.ErrScheduleNotFound) {
+		http.Error(w, "Schedule not found", http.StatusNotFound)
+		return models.Schedule{}, false
+	}
+	if err != nil {
+		log.Printf("Database error: %v", err)
+		http.Error(w, "Failed to get schedule", http.StatusInternalServerError)
+		return models.Schedule{}, false
+	}
+	return schedule, true
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
+)
+
+// PauseScheduleHandler는 스케줄을 삭제하지 않고 cron 작업만 제거하여 일시 중지합니다.
+func PauseScheduleHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return setScheduleEnabledHandler(scheduler, db, false)
+}
+
+// ResumeScheduleHandler는 일시 중지된 스케줄을 다시 cron에 등록합니다.
+func ResumeScheduleHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return setScheduleEnabledHandler(scheduler, db, true)
+}
+
+func setScheduleEnabledHandler(scheduler *scheduler.Scheduler, db *database.DB, enabled bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schedule, ok := scheduleFromRequest(w, r, db)
+		if !ok {
+			return
+		}
+
+		schedule.Enabled = enabled
+		if err := db.UpdateSchedule(&schedule); err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to update schedule", http.StatusInternalServerError)
+			return
+		}
+
+		if enabled {
+			if err := scheduler.ScheduleGroup(schedule); err != nil {
+				log.Printf("Failed to resume schedule %d: %v", schedule.ID, err)
+				http.Error(w, "Failed to resume schedule", http.StatusInternalServerError)
+				return
+			}
+		} else {
+			scheduler.UnscheduleGroup(schedule.ID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(newScheduleResponse(scheduler, schedule))
+	}
+}
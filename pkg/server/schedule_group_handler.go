@@ -9,12 +9,29 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
 )
 
 type ScheduleRequest struct {
-	StartTime string `json:"start_time"`
-	StopTime  string `json:"stop_time"`
+	StartTime  string   `json:"start_time"`
+	StopTime   string   `json:"stop_time"`
+	Timezone   string   `json:"timezone"`    // IANA 타임존 이름 (예: Asia/Seoul), 생략 시 서버 로컬 시간
+	Days       []string `json:"days"`        // 실행 요일 (mon~sun, weekdays, weekends), 생략 시 매일
+	CalendarID *int     `json:"calendar_id"` // 시작 작업을 건너뛸 휴일 캘린더 ID
+}
+
+// cronDays는 요청에서 허용하는 요일 이름과 cron 요일 필드 값의 매핑입니다.
+var cronDays = map[string]string{
+	"mon": "MON", "monday": "MON",
+	"tue": "TUE", "tuesday": "TUE",
+	"wed": "WED", "wednesday": "WED",
+	"thu": "THU", "thursday": "THU",
+	"fri": "FRI", "friday": "FRI",
+	"sat": "SAT", "saturday": "SAT",
+	"sun": "SUN", "sunday": "SUN",
+	"weekdays": "MON-FRI",
+	"weekends": "SAT,SUN",
 }
 
 func ScheduleGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
@@ -22,6 +39,13 @@ func ScheduleGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.
 		vars := mux.Vars(r)
 		groupID := vars["group_id"]
 
+		exists, err := db.GroupExists(groupID)
+		if err != nil || !exists {
+			log.Printf("Group %s not found: %v", groupID, err)
+			http.Error(w, "Group not found", http.StatusNotFound)
+			return
+		}
+
 		var req ScheduleRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			log.Printf("Error decoding request body: %v", err)
@@ -29,40 +53,126 @@ func ScheduleGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.
 			return
 		}
 
-		// ✅ HH:mm -> cron 포맷으로 변환
-		startCron, err := convertToCron(req.StartTime)
+		requested, ok := parseScheduleRequest(w, req, db)
+		if !ok {
+			return
+		}
+
+		// 같은 스케줄이 중복 등록되지 않도록 확인
+		duplicate, err := db.ScheduleExists(groupID, requested)
 		if err != nil {
-			log.Printf("Invalid start time format: %v", err)
-			http.Error(w, "Invalid start time format", http.StatusBadRequest)
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to create schedule", http.StatusInternalServerError)
+			return
+		}
+		if duplicate {
+			http.Error(w, "Schedule already exists for group", http.StatusConflict)
 			return
 		}
 
-		stopCron, err := convertToCron(req.StopTime)
+		// 재시작 후에도 유지되도록 스케줄을 데이터베이스에 먼저 저장
+		schedule, err := db.AddSchedule(groupID, requested)
 		if err != nil {
-			log.Printf("Invalid stop time format: %v", err)
-			http.Error(w, "Invalid stop time format", http.StatusBadRequest)
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to create schedule", http.StatusInternalServerError)
 			return
 		}
 
 		// 스케줄 추가 로직
-		err = scheduler.ScheduleGroup(groupID, startCron, stopCron)
+		err = scheduler.ScheduleGroup(schedule)
 		if err != nil {
 			log.Printf("Failed to schedule group %s: %v", groupID, err)
+			if err := db.DeleteSchedule(schedule.ID); err != nil {
+				log.Printf("Database error: %v", err)
+			}
 			http.Error(w, "Failed to create schedule", http.StatusInternalServerError)
 			return
 		}
 
-		response := map[string]string{
-			"status":  "success",
-			"message": "Schedule successfully created for group " + groupID,
+		response := map[string]interface{}{
+			"status":      "success",
+			"message":     "Schedule successfully created for group " + groupID,
+			"schedule_id": schedule.ID,
 		}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(response)
 	}
 }
 
-// convertToCron은 HH:mm 형식을 cron 포맷으로 변환합니다.
-func convertToCron(hhmm string) (string, error) {
+// parseScheduleRequest는 요청의 HH:mm 시각과 요일을 cron 포맷으로 변환하고 시각, 타임존, 휴일 캘린더의 유효성을 검사합니다.
+// 실패한 경우 에러 응답을 작성하고 ok로 false를 반환합니다.
+func parseScheduleRequest(w http.ResponseWriter, req ScheduleRequest, db *database.DB) (models.Schedule, bool) {
+	days, err := convertDaysToCron(req.Days)
+	if err != nil {
+		log.Printf("Invalid days: %v", err)
+		http.Error(w, "Invalid days", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	// ✅ HH:mm -> cron 포맷으로 변환
+	startCron, err := convertToCron(req.StartTime, days)
+	if err != nil {
+		log.Printf("Invalid start time format: %v", err)
+		http.Error(w, "Invalid start time format", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	stopCron, err := convertToCron(req.StopTime, days)
+	if err != nil {
+		log.Printf("Invalid stop time format: %v", err)
+		http.Error(w, "Invalid stop time format", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	if err := scheduler.ValidateTimezone(req.Timezone); err != nil {
+		log.Printf("Invalid timezone: %v", err)
+		http.Error(w, "Invalid timezone", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	// 범위를 벗어난 시각 (예: 25:00) 은 cron 파서에서 걸러냄
+	schedule := models.Schedule{StartCron: startCron, StopCron: stopCron, Timezone: req.Timezone, CalendarID: req.CalendarID}
+	if err := scheduler.ValidateSchedule(schedule); err != nil {
+		log.Printf("Invalid schedule: %v", err)
+		http.Error(w, "Invalid schedule time", http.StatusBadRequest)
+		return models.Schedule{}, false
+	}
+
+	if req.CalendarID != nil {
+		exists, err := db.CalendarExists(*req.CalendarID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to check calendar", http.StatusInternalServerError)
+			return models.Schedule{}, false
+		}
+		if !exists {
+			http.Error(w, "Calendar not found", http.StatusBadRequest)
+			return models.Schedule{}, false
+		}
+	}
+
+	return schedule, true
+}
+
+// convertDaysToCron은 요일 목록을 cron 요일 필드로 변환합니다. 빈 목록은 매일 ("?") 을 의미합니다.
+func convertDaysToCron(days []string) (string, error) {
+	if len(days) == 0 {
+		return "?", nil
+	}
+
+	fields := make([]string, 0, len(days))
+	for _, day := range days {
+		field, ok := cronDays[strings.ToLower(strings.TrimSpace(day))]
+		if !ok {
+			return "", fmt.Errorf("unknown day: %s", day)
+		}
+		fields = append(fields, field)
+	}
+	return strings.Join(fields, ","), nil
+}
+
+// convertToCron은 HH:mm 형식과 cron 요일 필드를 cron 포맷으로 변환합니다.
+func convertToCron(hhmm, days string) (string, error) {
 	parts := strings.Split(hhmm, ":")
 	if len(parts) != 2 {
 		return "", fmt.Errorf("invalid time format: %s", hhmm)
@@ -71,6 +181,6 @@ func convertToCron(hhmm string) (string, error) {
 	hour := parts[0]
 	minute := parts[1]
 
-	// cron 포맷: 초 분 시 일 월 요일 (예: "0 30 2 * * ?")
-	return fmt.Sprintf("0 %s %s * * ?", minute, hour), nil
+	// cron 포맷: 초 분 시 일 월 요일 (예: "0 30 2 * * ?", "0 30 2 * * MON-FRI")
+	return fmt.Sprintf("0 %s %s * * %s", minute, hour, days), nil
 }
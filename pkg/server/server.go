@@ -21,12 +21,32 @@ func StartServer(scheduler *scheduler.Scheduler, db *database.DB) {
 	// API 경로 및 핸들러 연결
 	router.HandleFunc("/api/v1/login", LoginHandler).Methods("POST")
 	router.HandleFunc("/api/v1/resource-groups", auth.Middleware(AddResourceGroupHandler(db))).Methods("POST")
-	router.HandleFunc("/api/v1/resource-groups/{group_id}", auth.Middleware(DeleteResourceGroupHandler(db))).Methods("DELETE")
+	router.HandleFunc("/api/v1/resource-groups/{group_id}", auth.Middleware(DeleteResourceGroupHandler(scheduler, db))).Methods("DELETE")
 	router.HandleFunc("/api/v1/groups", auth.Middleware(GetGroupsHandler(db))).Methods("GET")
 	router.HandleFunc("/api/v1/groups/{group_id}", auth.Middleware(GetGroupHandler(db))).Methods("GET")
 	router.HandleFunc("/api/v1/groups/{group_id}/start", auth.Middleware(StartGroupHandler(scheduler, db))).Methods("POST")
 	router.HandleFunc("/api/v1/groups/{group_id}/stop", auth.Middleware(StopGroupHandler(scheduler, db))).Methods("POST")
+	router.HandleFunc("/api/v1/groups/{group_id}/resources", auth.Middleware(GetGroupResourcesHandler(scheduler, db))).Methods("GET")
+	router.HandleFunc("/api/v1/groups/{group_id}/actions", auth.Middleware(GetGroupActionsHandler(db))).Methods("GET")
 	router.HandleFunc("/api/v1/groups/{group_id}/schedule", auth.Middleware(ScheduleGroupHandler(scheduler, db))).Methods("POST")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedule", auth.Middleware(GetSchedulesHandler(scheduler, db))).Methods("GET")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules", auth.Middleware(ScheduleGroupHandler(scheduler, db))).Methods("POST")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules", auth.Middleware(GetSchedulesHandler(scheduler, db))).Methods("GET")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules/{schedule_id}", auth.Middleware(GetScheduleHandler(scheduler, db))).Methods("GET")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules/{schedule_id}", auth.Middleware(UpdateScheduleHandler(scheduler, db))).Methods("PUT")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules/{schedule_id}", auth.Middleware(DeleteScheduleHandler(scheduler, db))).Methods("DELETE")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules/{schedule_id}/pause", auth.Middleware(PauseScheduleHandler(scheduler, db))).Methods("POST")
+	router.HandleFunc("/api/v1/groups/{group_id}/schedules/{schedule_id}/resume", auth.Middleware(ResumeScheduleHandler(scheduler, db))).Methods("POST")
+	router.HandleFunc("/api/v1/calendars", auth.Middleware(AddCalendarHandler(db))).Methods("POST")
+	router.HandleFunc("/api/v1/calendars", auth.Middleware(GetCalendarsHandler(db))).Methods("GET")
+	router.HandleFunc("/api/v1/calendars/{calendar_id}", auth.Middleware(GetCalendarHandler(db))).Methods("GET")
+	router.HandleFunc("/api/v1/calendars/{calendar_id}", auth.Middleware(UpdateCalendarHandler(db))).Methods("PUT")
+	router.HandleFunc("/api/v1/calendars/{calendar_id}", auth.Middleware(DeleteCalendarHandler(db))).Methods("DELETE")
+	router.HandleFunc("/api/v1/accounts", auth.Middleware(AddAccountHandler(db))).Methods("POST")
+	router.HandleFunc("/api/v1/accounts", auth.Middleware(GetAccountsHandler(db))).Methods("GET")
+	router.HandleFunc("/api/v1/accounts/{account_id}", auth.Middleware(GetAccountHandler(db))).Methods("GET")
+	router.HandleFunc("/api/v1/accounts/{account_id}", auth.Middleware(DeleteAccountHandler(db))).Methods("DELETE")
+	router.HandleFunc("/api/v1/resource-types", auth.Middleware(GetResourceTypesHandler())).Methods("GET")
 	router.HandleFunc("/api/v1/actions/{action_id}", auth.Middleware(GetActionStatusHandler(db))).Methods("GET")
 
 	corsHandler := handlers.CORS(
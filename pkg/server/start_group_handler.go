@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/internal/auth"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
 )
 
@@ -15,15 +18,27 @@ func StartGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.Han
 		vars := mux.Vars(r)
 		groupID := vars["group_id"]
 
-		actionID, err := scheduler.StartGroup(groupID)
+		// dry_run=true이면 작업을 실행하지 않고 대상 리소스만 반환
+		if isDryRun(r) {
+			previewGroupAction(w, scheduler, db, groupID, models.ActionStart)
+			return
+		}
+
+		exists, err := db.GroupExists(groupID)
+		if err != nil || !exists {
+			log.Printf("Group %s not found: %v", groupID, err)
+			http.Error(w, "Group not found", http.StatusNotFound)
+			return
+		}
+
+		// 작업 기록과 진행 상태는 스케줄러가 저장
+		actionID, err := scheduler.StartGroup(groupID, actionOriginFromRequest(r))
 		if err != nil {
 			log.Printf("Scheduler error: %v", err)
 			http.Error(w, "Failed to start group", http.StatusInternalServerError)
 			return
 		}
 
-		db.RecordAction(actionID, groupID, "start")
-
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(map[string]string{
 			"status":    "success",
@@ -32,3 +47,16 @@ func StartGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.Han
 		})
 	}
 }
+
+// actionOriginFromRequest는 JWT 사용자와 override 쿼리 파라미터로 작업 요청 주체를 구성합니다.
+// override=true는 그룹의 스케줄과 다르게 의도적으로 시작/중지하는 수동 요청을 의미합니다.
+func actionOriginFromRequest(r *http.Request) models.ActionOrigin {
+	origin := models.ActionOrigin{Trigger: models.TriggerAPI}
+	if override, _ := strconv.ParseBool(r.URL.Query().Get("override")); override {
+		origin.Trigger = models.TriggerOverride
+	}
+	if user := auth.GetUserFromContext(r.Context()); user != nil {
+		origin.RequestedBy = user.Subject
+	}
+	return origin
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/models"
 	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
 )
 
@@ -15,15 +16,27 @@ func StopGroupHandler(scheduler *scheduler.Scheduler, db *database.DB) http.Hand
 		vars := mux.Vars(r)
 		groupID := vars["group_id"]
 
-		actionID, err := scheduler.StopGroup(groupID)
+		// dry_run=true이면 작업을 실행하지 않고 대상 리소스만 반환
+		if isDryRun(r) {
+			previewGroupAction(w, scheduler, db, groupID, models.ActionStop)
+			return
+		}
+
+		exists, err := db.GroupExists(groupID)
+		if err != nil || !exists {
+			log.Printf("Group %s not found: %v", groupID, err)
+			http.Error(w, "Group not found", http.StatusNotFound)
+			return
+		}
+
+		// 작업 기록과 진행 상태는 스케줄러가 저장
+		actionID, err := scheduler.StopGroup(groupID, actionOriginFromRequest(r))
 		if err != nil {
 			log.Printf("Scheduler error: %v", err)
 			http.Error(w, "Failed to stop group", http.StatusInternalServerError)
 			return
 		}
 
-		db.RecordAction(actionID, groupID, "stop")
-
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(map[string]string{
 			"status":    "success",
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+)
+
+// UpdateCalendarHandler는 휴일 캘린더의 휴일 목록을 업로드한 내용으로 교체하는 핸들러입니다.
+// 생성과 동일하게 JSON 날짜 목록 또는 ICS 파일을 받으며, 캘린더 이름은 변경하지 않습니다.
+func UpdateCalendarHandler(db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calendarID, ok := calendarIDFromRequest(w, r)
+		if !ok {
+			return
+		}
+
+		_, holidays, err := parseCalendarUpload(r)
+		if err != nil {
+			log.Printf("Invalid calendar upload: %v", err)
+			http.Error(w, "Invalid calendar: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = db.ReplaceCalendarHolidays(calendarID, holidays)
+		if errors.Is(err, database.ErrCalendarNotFound) {
+			http.Error(w, "Calendar not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to update calendar", http.StatusInternalServerError)
+			return
+		}
+
+		calendar, err := db.GetCalendar(calendarID)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to get calendar", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(calendar)
+	}
+}
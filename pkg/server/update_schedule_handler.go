@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/database"
+	"github.com/yoonhyunwoo/cloudtoggle/pkg/scheduler"
+)
+
+// UpdateScheduleHandler는 기존 스케줄의 시작/중지 시각, 요일, 타임존, 휴일 캘린더를 변경하는 핸들러입니다.
+func UpdateScheduleHandler(scheduler *scheduler.Scheduler, db *database.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		schedule, ok := scheduleFromRequest(w, r, db)
+		if !ok {
+			return
+		}
+		groupID := mux.Vars(r)["group_id"]
+
+		var req ScheduleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Printf("Error decoding request body: %v", err)
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		requested, ok := parseScheduleRequest(w, req, db)
+		if !ok {
+			return
+		}
+
+		previous := schedule
+		schedule.StartCron = requested.StartCron
+		schedule.StopCron = requested.StopCron
+		schedule.Timezone = requested.Timezone
+		schedule.CalendarID = requested.CalendarID
+
+		// 변경 결과가 그룹의 다른 스케줄과 같아지지 않도록 확인
+		duplicate, err := db.ScheduleExists(groupID, schedule)
+		if err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to update schedule", http.StatusInternalServerError)
+			return
+		}
+		if duplicate {
+			http.Error(w, "Schedule already exists for group", http.StatusConflict)
+			return
+		}
+
+		if err := db.UpdateSchedule(&schedule); err != nil {
+			log.Printf("Database error: %v", err)
+			http.Error(w, "Failed to update schedule", http.StatusInternalServerError)
+			return
+		}
+
+		// 일시 중지된 스케줄은 재개될 때 등록되므로 활성 스케줄만 다시 등록
+		// 등록에 실패하면 기존 cron 작업은 그대로 남으므로 데이터베이스도 이전 값으로 되돌림
+		if schedule.Enabled {
+			if err := scheduler.ScheduleGroup(schedule); err != nil {
+				log.Printf("Failed to reschedule schedule %d: %v", schedule.ID, err)
+				if err := db.UpdateSchedule(&previous); err != nil {
+					log.Printf("Failed to roll back schedule %d: %v", schedule.ID, err)
+				}
+				http.Error(w, "Failed to update schedule", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(newScheduleResponse(scheduler, schedule))
+	}
+}